@@ -0,0 +1,11 @@
+begin;
+
+alter table example.services
+    drop column if exists owner_team,
+    drop column if exists version,
+    drop column if exists endpoints,
+    drop column if exists labels,
+    drop column if exists created_at,
+    drop column if exists updated_at;
+
+end;
@@ -0,0 +1,11 @@
+begin;
+
+alter table example.services
+    add column if not exists owner_team text        not null default '',
+    add column if not exists version    text        not null default '',
+    add column if not exists endpoints  jsonb       not null default '[]',
+    add column if not exists labels     jsonb       not null default '{}',
+    add column if not exists created_at timestamptz not null default now(),
+    add column if not exists updated_at timestamptz not null default now();
+
+end;
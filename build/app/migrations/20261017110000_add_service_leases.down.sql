@@ -0,0 +1,11 @@
+begin;
+
+drop index if exists example.services_expired_at_idx;
+drop index if exists example.services_lease_expires_at_idx;
+
+alter table example.services
+    drop column if exists lease_ttl_seconds,
+    drop column if exists lease_expires_at,
+    drop column if exists expired_at;
+
+end;
@@ -0,0 +1,16 @@
+begin;
+
+alter table example.services
+    add column if not exists lease_ttl_seconds bigint      not null default 0,
+    add column if not exists lease_expires_at  timestamptz,
+    add column if not exists expired_at        timestamptz;
+
+create index if not exists services_lease_expires_at_idx
+    on example.services (lease_expires_at)
+    where lease_expires_at is not null and expired_at is null;
+
+create index if not exists services_expired_at_idx
+    on example.services (expired_at)
+    where expired_at is not null;
+
+end;
@@ -0,0 +1,17 @@
+begin;
+
+create table if not exists example.service_changes
+(
+    revision     bigserial primary key,
+    change_type  text        not null,
+    service_name text        not null,
+    service      jsonb       not null,
+    changed_at   timestamptz not null default now()
+);
+
+create index if not exists service_changes_changed_at_idx
+    on example.service_changes (changed_at);
+
+alter table example.service_changes owner to postgres;
+
+end;
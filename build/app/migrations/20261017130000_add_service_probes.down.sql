@@ -0,0 +1,8 @@
+begin;
+
+drop table if exists example.service_health;
+
+alter table example.services
+    drop column if exists probe;
+
+end;
@@ -0,0 +1,18 @@
+begin;
+
+alter table example.services
+    add column if not exists probe jsonb;
+
+create table if not exists example.service_health
+(
+    service_name         text primary key
+        references example.services (service_name) on update cascade on delete cascade,
+    consecutive_failures integer     not null default 0,
+    latency_us           bigint      not null default 0,
+    last_error           text        not null default '',
+    probed_at            timestamptz not null default now()
+);
+
+alter table example.service_health owner to postgres;
+
+end;
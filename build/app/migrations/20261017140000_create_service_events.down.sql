@@ -0,0 +1,5 @@
+begin;
+
+drop table if exists example.service_events;
+
+end;
@@ -0,0 +1,19 @@
+begin;
+
+create table if not exists example.service_events
+(
+    id           bigserial primary key,
+    service_name text        not null,
+    event_type   text        not null,
+    actor        text        not null,
+    old_value    jsonb,
+    new_value    jsonb,
+    occurred_at  timestamptz not null default now()
+);
+
+create index if not exists service_events_service_name_id_idx
+    on example.service_events (service_name, id);
+
+alter table example.service_events owner to postgres;
+
+end;
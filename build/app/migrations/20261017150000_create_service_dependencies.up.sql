@@ -0,0 +1,19 @@
+begin;
+
+-- the repository drops the dependencies on a service before deleting it, recording the update of every
+-- dependent; the cascades only keep the table consistent with writes that bypass it
+create table if not exists example.service_dependencies
+(
+    service_name text not null
+        references example.services (service_name) on update cascade on delete cascade,
+    depends_on   text not null
+        references example.services (service_name) on update cascade on delete cascade,
+    primary key (service_name, depends_on)
+);
+
+create index if not exists service_dependencies_depends_on_idx
+    on example.service_dependencies (depends_on);
+
+alter table example.service_dependencies owner to postgres;
+
+end;
@@ -0,0 +1,5 @@
+begin;
+
+drop index if exists example.services_labels_idx;
+
+end;
@@ -0,0 +1,7 @@
+begin;
+
+-- serves the containment and existence checks label selectors compile to
+create index if not exists services_labels_idx
+    on example.services using gin (labels);
+
+end;
@@ -0,0 +1,6 @@
+begin;
+
+alter table example.services
+    drop column if exists resource_version;
+
+end;
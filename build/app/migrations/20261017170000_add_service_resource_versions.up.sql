@@ -0,0 +1,6 @@
+begin;
+
+alter table example.services
+    add column if not exists resource_version bigint not null default 1;
+
+end;
@@ -0,0 +1,6 @@
+begin;
+
+alter table example.services
+    add column if not exists description text not null default '';
+
+end;
@@ -0,0 +1,9 @@
+begin;
+
+alter table example.service_changes
+    drop column if exists instance;
+
+drop table if exists example.service_instance_endpoints;
+drop table if exists example.service_instances;
+
+end;
@@ -0,0 +1,38 @@
+begin;
+
+-- instances of a service register and renew their leases on their own, the reaper deletes an instance
+-- as soon as it misses its lease, so a crashed instance stops being resolved without anyone's help
+create table if not exists example.service_instances
+(
+    service_name      text        not null
+        references example.services (service_name) on update cascade on delete cascade,
+    instance_id       text        not null,
+    lease_ttl_seconds bigint      not null default 0,
+    lease_expires_at  timestamptz,
+    registered_at     timestamptz not null default now(),
+    primary key (service_name, instance_id)
+);
+
+create index if not exists service_instances_lease_expires_at_idx
+    on example.service_instances (lease_expires_at)
+    where lease_expires_at is not null;
+
+create table if not exists example.service_instance_endpoints
+(
+    service_name text not null,
+    instance_id  text not null,
+    protocol     text not null,
+    address      text not null,
+    primary key (service_name, instance_id, protocol, address),
+    foreign key (service_name, instance_id)
+        references example.service_instances (service_name, instance_id) on update cascade on delete cascade
+);
+
+-- changes of instances carry the instance along with the service it belongs to
+alter table example.service_changes
+    add column if not exists instance jsonb;
+
+alter table example.service_instances owner to postgres;
+alter table example.service_instance_endpoints owner to postgres;
+
+end;
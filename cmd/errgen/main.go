@@ -0,0 +1,231 @@
+// Command errgen generates the typed reasons and the error documentation from the error catalog.
+//
+//	go run ./cmd/errgen -catalog gen/reasons/catalog.yaml -out gen/reasons/catalog_gen.go -doc gen/docs/errors.md
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"gopkg.in/yaml.v3"
+)
+
+type catalog struct {
+	Reasons []*definition `yaml:"reasons"`
+}
+
+type definition struct {
+	Reason      string `yaml:"reason"`
+	Code        string `yaml:"code"`
+	HTTP        int    `yaml:"http"`
+	Retryable   bool   `yaml:"retryable"`
+	Title       string `yaml:"title"`
+	Description string `yaml:"description"`
+
+	code codes.Code
+}
+
+// reasonPattern is the UPPER_SNAKE_CASE google.rpc.ErrorInfo requires of reasons.
+var reasonPattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]*[A-Z0-9]$`)
+
+func main() {
+	var catalogPath, outPath, docPath string
+
+	flag.StringVar(&catalogPath, "catalog", "catalog.yaml", "definitions of the reasons")
+	flag.StringVar(&outPath, "out", "catalog_gen.go", "go file to generate")
+	flag.StringVar(&docPath, "doc", "", "markdown documentation to generate, skipped when empty")
+	flag.Parse()
+
+	if err := run(catalogPath, outPath, docPath); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(catalogPath, outPath, docPath string) error {
+	raw, err := os.ReadFile(catalogPath)
+	if err != nil {
+		return fmt.Errorf("cannot read catalog | %w", err)
+	}
+
+	var c catalog
+
+	decoder := yaml.NewDecoder(bytes.NewReader(raw))
+	decoder.KnownFields(true)
+
+	if err = decoder.Decode(&c); err != nil {
+		return fmt.Errorf("cannot parse catalog | %w", err)
+	}
+
+	if err = c.check(); err != nil {
+		return fmt.Errorf("invalid catalog | %w", err)
+	}
+
+	source, err := render(goTemplate, c, filepath.Base(catalogPath))
+	if err != nil {
+		return err
+	}
+
+	if source, err = format.Source(source); err != nil {
+		return fmt.Errorf("cannot format generated code | %w", err)
+	}
+
+	if err = os.WriteFile(outPath, source, 0o644); err != nil {
+		return fmt.Errorf("cannot write %s | %w", outPath, err)
+	}
+
+	if docPath == "" {
+		return nil
+	}
+
+	doc, err := render(docTemplate, c, filepath.Base(catalogPath))
+	if err != nil {
+		return err
+	}
+
+	if err = os.WriteFile(docPath, doc, 0o644); err != nil {
+		return fmt.Errorf("cannot write %s | %w", docPath, err)
+	}
+
+	return nil
+}
+
+// check validates the definitions and fills in the codes and the default HTTP statuses.
+func (c *catalog) check() error {
+	if len(c.Reasons) == 0 {
+		return errors.New("no reasons")
+	}
+
+	seen := make(map[string]bool, len(c.Reasons))
+
+	for _, d := range c.Reasons {
+		if !reasonPattern.MatchString(d.Reason) {
+			return fmt.Errorf("reason %q is not UPPER_SNAKE_CASE", d.Reason)
+		}
+
+		if seen[d.Reason] {
+			return fmt.Errorf("reason %s is declared twice", d.Reason)
+		}
+
+		seen[d.Reason] = true
+
+		if err := d.code.UnmarshalJSON([]byte(strconv.Quote(d.Code))); err != nil {
+			return fmt.Errorf("reason %s has unknown code %q", d.Reason, d.Code)
+		}
+
+		if d.code == codes.OK {
+			return fmt.Errorf("reason %s has code OK", d.Reason)
+		}
+
+		if d.HTTP == 0 {
+			d.HTTP = runtime.HTTPStatusFromCode(d.code)
+		}
+
+		if d.HTTP < 400 || d.HTTP > 599 {
+			return fmt.Errorf("reason %s has http status %d, not an error", d.Reason, d.HTTP)
+		}
+
+		if strings.TrimSpace(d.Title) == "" || strings.TrimSpace(d.Description) == "" {
+			return fmt.Errorf("reason %s lacks a title or a description", d.Reason)
+		}
+	}
+
+	return nil
+}
+
+// Ident is the name of the Go constant, SERVICE_NOT_FOUND becomes ServiceNotFound.
+func (d *definition) Ident() string {
+	var ident strings.Builder
+
+	for word := range strings.SplitSeq(strings.ToLower(d.Reason), "_") {
+		if word == "" {
+			continue
+		}
+
+		ident.WriteString(strings.ToUpper(word[:1]) + word[1:])
+	}
+
+	return ident.String()
+}
+
+// GoCode is the name of the code in the codes package, such as NotFound.
+func (d *definition) GoCode() string {
+	return "codes." + d.code.String()
+}
+
+func render(text string, c catalog, source string) ([]byte, error) {
+	tmpl, err := template.New("").Funcs(template.FuncMap{
+		"oneLine": func(s string) string { return strings.Join(strings.Fields(s), " ") },
+		"quote":   strconv.Quote,
+		"lower":   strings.ToLower,
+	}).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse template | %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err = tmpl.Execute(&buf, map[string]any{"Reasons": c.Reasons, "Source": source}); err != nil {
+		return nil, fmt.Errorf("cannot render template | %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+const goTemplate = `// Code generated by errgen from {{ .Source }}. DO NOT EDIT.
+
+package reasons
+
+import "google.golang.org/grpc/codes"
+
+const (
+{{- range .Reasons }}
+	// {{ .Ident }}: {{ oneLine .Description }}
+	{{ .Ident }} Reason = {{ quote .Reason }}
+{{- end }}
+)
+
+var all = []Reason{
+{{- range .Reasons }}
+	{{ .Ident }},
+{{- end }}
+}
+
+var catalog = map[Reason]definition{
+{{- range .Reasons }}
+	{{ .Ident }}: {code: {{ .GoCode }}, httpStatus: {{ .HTTP }}, retryable: {{ .Retryable }}, title: {{ quote .Title }}},
+{{- end }}
+}
+`
+
+const docTemplate = `<!-- Code generated by errgen from {{ .Source }}. DO NOT EDIT. -->
+
+# Errors
+
+Every error of the API carries a ` + "`google.rpc.ErrorInfo`" + ` with one of the reasons below and the domain
+` + "`mattis.dev`" + `. Clients match on the reason, the message is for developers and may change.
+The gateway responds with ` + "`application/problem+json`" + `, its ` + "`type`" + ` is derived from the reason.
+A ` + "`google.rpc.LocalizedMessage`" + `, the ` + "`detail`" + ` of a problem, has a message for end users in the language
+asked for with the ` + "`accept-language`" + ` metadata or the ` + "`Accept-Language`" + ` header, see [the bundles](../../src/i18n/bundles).
+
+| Reason | gRPC code | HTTP | Retryable | Title |
+|--------|-----------|------|-----------|-------|
+{{- range .Reasons }}
+| [` + "`{{ .Reason }}`" + `](#{{ lower .Reason }}) | ` + "`{{ .Code }}`" + ` | {{ .HTTP }} | {{ if .Retryable }}yes{{ else }}no{{ end }} | {{ .Title }} |
+{{- end }}
+{{ range .Reasons }}
+## {{ .Reason }}
+
+{{ oneLine .Description }}
+{{ end -}}
+`
@@ -20,6 +20,15 @@ import (
 )
 
 func main() {
+	if len(os.Args) > 1 {
+		if err := runCommand(os.Args[1], os.Args[2:]); err != nil {
+			_, _ = fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+
+		return
+	}
+
 	serverCTX, serverCancel := context.WithCancel(context.Background())
 
 	envBox, err := box.NewENV(serverCTX)
@@ -52,10 +61,40 @@ func main() {
 
 			return nil
 		},
+		func() error {
+			if resources.Certificates != nil {
+				resources.Certificates.Watch(serverCTX, envBox.Config.TLSConfig.ReloadInterval)
+			}
+
+			return nil
+		},
 		func() error {
 			resources.TelegramBot.Start()
 			return nil
 		},
+		func() error {
+			if listenErr := envBox.ChangesListener.Listen(serverCTX); listenErr != nil {
+				return fmt.Errorf("cannot listen for service changes | %w", listenErr)
+			}
+
+			return nil
+		},
+		func() error {
+			resources.ExampleService.ReapLeases(
+				serverCTX, envBox.Config.LeaseConfig.ReaperInterval, envBox.Config.LeaseConfig.ExpiredRetention,
+			)
+			return nil
+		},
+		func() error {
+			resources.ExampleService.ProbeServices(serverCTX)
+			return nil
+		},
+		func() error {
+			resources.ExampleService.PruneChanges(
+				serverCTX, envBox.Config.WatchConfig.ChangesPruneInterval, envBox.Config.WatchConfig.ChangesRetention,
+			)
+			return nil
+		},
 		func() error {
 			if resources.MetricsServer.Name() == server.NotOperational {
 				return nil
@@ -80,8 +119,9 @@ func main() {
 	}
 
 	gracefullShutdown(
-		envBox.Logger,
-		resources.GRPCServer, envBox.PGXPool, resources.TelegramBot,
+		envBox.Logger, envBox.Config.ShutdownTimeout,
+		resources.ExampleService, envBox.ChangesListener, envBox.PGXPool, resources.TelegramBot,
+		resources.GRPCServer,
 		resources.MetricsServer,
 		envBox.TraceProvider,
 	)
@@ -105,8 +145,9 @@ type (
 )
 
 func gracefullShutdown(
-	logger *log.Zap,
-	serverGRPC, pgxPool, telegramBot closer,
+	logger *log.Zap, timeout time.Duration,
+	exampleService, changesListener, pgxPool, telegramBot closer,
+	servers shutdowner,
 	metricsServerHTTP metricsCloser,
 	traceProvider shutdowner,
 ) {
@@ -118,11 +159,31 @@ func gracefullShutdown(
 
 	logger.Info("shutting down service...")
 
+	// closed once the servers are drained, the requests they wait for may still need the database
+	drained := make(chan struct{})
+
 	shutdownWG := &sync.WaitGroup{}
 	shutdownFunctions := []func(){
 		func() {
 			defer shutdownWG.Done()
-			serverGRPC.Close()
+			// ends running watches, otherwise the graceful stop of grpc waits for them forever
+			exampleService.Close()
+		},
+		func() {
+			defer shutdownWG.Done()
+			changesListener.Close()
+		},
+		func() {
+			defer shutdownWG.Done()
+			defer close(drained)
+
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+
+			// drains the gateway and grpc, requests still running at the deadline are cut off
+			if err := servers.Shutdown(ctx); err != nil {
+				logger.Error("failed to shutdown servers gracefully", zap.Error(err))
+			}
 		},
 		func() {
 			defer shutdownWG.Done()
@@ -140,6 +201,7 @@ func gracefullShutdown(
 		},
 		func() {
 			defer shutdownWG.Done()
+			<-drained
 			pgxPool.Close()
 		},
 		func() {
@@ -0,0 +1,454 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+	"gopkg.in/yaml.v3"
+
+	exampleGRPC "github.com/ingvarmattis/example/gen/servergrpc/example"
+	"github.com/ingvarmattis/example/src/actor"
+)
+
+const (
+	formatNDJSON = "ndjson"
+	formatYAML   = "yaml"
+
+	// maxRecordSize bounds a single NDJSON line.
+	maxRecordSize = 16 << 20
+)
+
+var errUsage = errors.New(`usage:
+  example                   run the service
+  example export [flags]    write the registry of a running service to a file
+  example import [flags]    load a file written by export into a running service
+run a command with -h to list its flags`)
+
+// runCommand runs a command line subcommand instead of the service.
+func runCommand(name string, args []string) error {
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	switch name {
+	case "export":
+		return exportCommand(ctx, args)
+	case "import":
+		return importCommand(ctx, args)
+	default:
+		return errUsage
+	}
+}
+
+type commonFlags struct {
+	addr   string
+	format string
+	actor  string
+
+	tls      bool
+	caFile   string
+	certFile string
+	keyFile  string
+}
+
+func (f *commonFlags) register(flags *flag.FlagSet) {
+	flags.StringVar(&f.addr, "addr", "localhost:8000", "grpc address of the service")
+	flags.StringVar(&f.format, "format", formatNDJSON, "file format, ndjson or yaml")
+	flags.StringVar(&f.actor, "actor", "cli:"+os.Getenv("USER"), "name recorded in the history of the changes")
+	flags.BoolVar(&f.tls, "tls", false, "connect over tls, implied by -ca and -cert")
+	flags.StringVar(&f.caFile, "ca", "", "ca bundle to verify the service with, the system roots when empty")
+	flags.StringVar(&f.certFile, "cert", "", "client certificate for mutual tls")
+	flags.StringVar(&f.keyFile, "key", "", "key of the client certificate")
+}
+
+// credentials are plaintext unless one of the tls flags is set.
+func (f *commonFlags) credentials() (credentials.TransportCredentials, error) {
+	if !f.tls && f.caFile == "" && f.certFile == "" && f.keyFile == "" {
+		return insecure.NewCredentials(), nil
+	}
+
+	config := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if f.caFile != "" {
+		raw, err := os.ReadFile(f.caFile)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read ca bundle | %w", err)
+		}
+
+		config.RootCAs = x509.NewCertPool()
+		if !config.RootCAs.AppendCertsFromPEM(raw) {
+			return nil, fmt.Errorf("no certificates in %s", f.caFile)
+		}
+	}
+
+	if (f.certFile == "") != (f.keyFile == "") {
+		return nil, errors.New("-cert and -key are expected together")
+	}
+
+	if f.certFile != "" {
+		certificate, err := tls.LoadX509KeyPair(f.certFile, f.keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("cannot load client certificate | %w", err)
+		}
+
+		config.Certificates = []tls.Certificate{certificate}
+	}
+
+	return credentials.NewTLS(config), nil
+}
+
+func (f *commonFlags) dial() (exampleGRPC.ExampleServiceClient, func(), error) {
+	if f.format != formatNDJSON && f.format != formatYAML {
+		return nil, nil, fmt.Errorf("unknown format %q, expected %s or %s", f.format, formatNDJSON, formatYAML)
+	}
+
+	transportCredentials, err := f.credentials()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	conn, err := grpc.NewClient(f.addr, grpc.WithTransportCredentials(transportCredentials))
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot connect to %s | %w", f.addr, err)
+	}
+
+	closeConn := func() {
+		_ = conn.Close()
+	}
+
+	return exampleGRPC.NewExampleServiceClient(conn), closeConn, nil
+}
+
+func exportCommand(ctx context.Context, args []string) error {
+	var (
+		common         commonFlags
+		out            string
+		includeHistory bool
+	)
+
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	common.register(flags)
+	flags.StringVar(&out, "out", "", "file to write, standard output when empty")
+	flags.BoolVar(&includeHistory, "history", false, "include the audit trail of the registry")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	client, closeConn, err := common.dial()
+	if err != nil {
+		return err
+	}
+	defer closeConn()
+
+	writer := io.Writer(os.Stdout)
+
+	if out != "" {
+		file, createErr := os.Create(out)
+		if createErr != nil {
+			return fmt.Errorf("cannot create %s | %w", out, createErr)
+		}
+		defer file.Close()
+
+		writer = file
+	}
+
+	buffered := bufio.NewWriter(writer)
+
+	ctx = metadata.AppendToOutgoingContext(ctx, actor.Header, common.actor)
+
+	stream, err := client.ExportServices(ctx, &exampleGRPC.ExportServicesRequest{IncludeHistory: includeHistory})
+	if err != nil {
+		return fmt.Errorf("cannot export services | %w", err)
+	}
+
+	encode, flush := newRecordEncoder(buffered, common.format)
+
+	for {
+		record, recvErr := stream.Recv()
+		if errors.Is(recvErr, io.EOF) {
+			break
+		}
+
+		if recvErr != nil {
+			return fmt.Errorf("cannot export services | %w", recvErr)
+		}
+
+		if err = encode(record); err != nil {
+			return err
+		}
+	}
+
+	if err = flush(); err != nil {
+		return fmt.Errorf("cannot write export | %w", err)
+	}
+
+	if err = buffered.Flush(); err != nil {
+		return fmt.Errorf("cannot write export | %w", err)
+	}
+
+	return nil
+}
+
+func importCommand(ctx context.Context, args []string) error {
+	var (
+		common importFlags
+		in     string
+	)
+
+	flags := flag.NewFlagSet("import", flag.ContinueOnError)
+	common.register(flags)
+	flags.StringVar(&in, "in", "", "file to read, standard input when empty")
+	flags.StringVar(&common.mode, "mode", "fail", "what to do with services registered differently: fail, skip or upsert")
+	flags.BoolVar(&common.dryRun, "dry-run", false, "report what would change without changing anything")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	mode, ok := importModes[common.mode]
+	if !ok {
+		return fmt.Errorf("unknown mode %q, expected fail, skip or upsert", common.mode)
+	}
+
+	reader := io.Reader(os.Stdin)
+
+	if in != "" {
+		file, err := os.Open(in)
+		if err != nil {
+			return fmt.Errorf("cannot open %s | %w", in, err)
+		}
+		defer file.Close()
+
+		reader = file
+	}
+
+	records, err := decodeRecords(reader, common.format)
+	if err != nil {
+		return err
+	}
+
+	client, closeConn, err := common.dial()
+	if err != nil {
+		return err
+	}
+	defer closeConn()
+
+	ctx = metadata.AppendToOutgoingContext(ctx, actor.Header, common.actor)
+
+	stream, err := client.ImportServices(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot import services | %w", err)
+	}
+
+	options := &exampleGRPC.ImportOptions{ConflictMode: mode, DryRun: common.dryRun}
+
+	if len(records) == 0 {
+		records = append(records, &exampleGRPC.ImportServicesRequest{})
+	}
+
+	records[0].Options = options
+
+	for _, record := range records {
+		if err = stream.Send(record); err != nil {
+			break
+		}
+	}
+
+	// a failed send is reported by CloseAndRecv with the status of the stream
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		return fmt.Errorf("cannot import services | %w", err)
+	}
+
+	printImportDiff(os.Stdout, resp)
+
+	return nil
+}
+
+type importFlags struct {
+	commonFlags
+
+	mode   string
+	dryRun bool
+}
+
+var importModes = map[string]exampleGRPC.ImportConflictMode{
+	"fail":   exampleGRPC.ImportConflictMode_IMPORT_CONFLICT_MODE_FAIL,
+	"skip":   exampleGRPC.ImportConflictMode_IMPORT_CONFLICT_MODE_SKIP,
+	"upsert": exampleGRPC.ImportConflictMode_IMPORT_CONFLICT_MODE_UPSERT,
+}
+
+func printImportDiff(w io.Writer, resp *exampleGRPC.ImportServicesResponse) {
+	counts := make(map[exampleGRPC.ImportAction]int)
+
+	for _, diff := range resp.GetDiff() {
+		counts[diff.GetAction()]++
+
+		action := strings.ToLower(strings.TrimPrefix(diff.GetAction().String(), "IMPORT_ACTION_"))
+
+		line := fmt.Sprintf("%-9s %s", action, diff.GetServiceName())
+		if len(diff.GetChangedFields()) > 0 {
+			line += " (" + strings.Join(diff.GetChangedFields(), ", ") + ")"
+		}
+
+		_, _ = fmt.Fprintln(w, line)
+	}
+
+	_, _ = fmt.Fprintf(w, "created %d, updated %d, unchanged %d, skipped %d, events %d\n",
+		counts[exampleGRPC.ImportAction_IMPORT_ACTION_CREATE],
+		counts[exampleGRPC.ImportAction_IMPORT_ACTION_UPDATE],
+		counts[exampleGRPC.ImportAction_IMPORT_ACTION_UNCHANGED],
+		counts[exampleGRPC.ImportAction_IMPORT_ACTION_SKIP],
+		resp.GetEventsImported(),
+	)
+
+	if resp.GetDryRun() {
+		_, _ = fmt.Fprintln(w, "dry run, nothing has been changed")
+	}
+}
+
+// newRecordEncoder writes a record per line for ndjson, and a document per record for yaml.
+// flush has to be called after the last record.
+func newRecordEncoder(
+	w io.Writer, format string,
+) (func(*exampleGRPC.ExportServicesResponse) error, func() error) {
+	marshaler := protojson.MarshalOptions{}
+
+	if format == formatNDJSON {
+		return func(record *exampleGRPC.ExportServicesResponse) error {
+			raw, err := marshaler.Marshal(record)
+			if err != nil {
+				return fmt.Errorf("cannot marshal record | %w", err)
+			}
+
+			if _, err = w.Write(append(raw, '\n')); err != nil {
+				return fmt.Errorf("cannot write record | %w", err)
+			}
+
+			return nil
+		}, func() error { return nil }
+	}
+
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+
+	return func(record *exampleGRPC.ExportServicesResponse) error {
+		raw, err := marshaler.Marshal(record)
+		if err != nil {
+			return fmt.Errorf("cannot marshal record | %w", err)
+		}
+
+		// json is valid yaml, decoding it into a node keeps the order of the fields
+		var node yaml.Node
+		if err = yaml.Unmarshal(raw, &node); err != nil {
+			return fmt.Errorf("cannot convert record to yaml | %w", err)
+		}
+
+		plainStyle(&node)
+
+		if err = encoder.Encode(&node); err != nil {
+			return fmt.Errorf("cannot write record | %w", err)
+		}
+
+		return nil
+	}, encoder.Close
+}
+
+// plainStyle drops the flow and quoted styles the nodes have when decoded from json,
+// the encoder still quotes strings that would otherwise read as another type.
+func plainStyle(node *yaml.Node) {
+	node.Style = 0
+
+	for _, child := range node.Content {
+		plainStyle(child)
+	}
+}
+
+func decodeRecords(r io.Reader, format string) ([]*exampleGRPC.ImportServicesRequest, error) {
+	var records []*exampleGRPC.ImportServicesRequest
+
+	add := func(raw []byte, position int) error {
+		var record exampleGRPC.ExportServicesResponse
+		if err := protojson.Unmarshal(raw, &record); err != nil {
+			return fmt.Errorf("cannot parse record %d | %w", position, err)
+		}
+
+		switch value := record.GetRecord().(type) {
+		case *exampleGRPC.ExportServicesResponse_Service:
+			records = append(records, &exampleGRPC.ImportServicesRequest{
+				Record: &exampleGRPC.ImportServicesRequest_Service{Service: value.Service},
+			})
+		case *exampleGRPC.ExportServicesResponse_Event:
+			records = append(records, &exampleGRPC.ImportServicesRequest{
+				Record: &exampleGRPC.ImportServicesRequest_Event{Event: value.Event},
+			})
+		}
+
+		return nil
+	}
+
+	if format == formatNDJSON {
+		scanner := bufio.NewScanner(r)
+		scanner.Buffer(make([]byte, 0, 64<<10), maxRecordSize)
+
+		for line := 1; scanner.Scan(); line++ {
+			if strings.TrimSpace(scanner.Text()) == "" {
+				continue
+			}
+
+			if err := add(scanner.Bytes(), line); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := scanner.Err(); err != nil {
+			return nil, fmt.Errorf("cannot read records | %w", err)
+		}
+
+		return records, nil
+	}
+
+	decoder := yaml.NewDecoder(r)
+
+	for document := 1; ; document++ {
+		var value any
+
+		err := decoder.Decode(&value)
+		if errors.Is(err, io.EOF) {
+			return records, nil
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse document %d | %w", document, err)
+		}
+
+		if value == nil {
+			continue
+		}
+
+		raw, err := json.Marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf("cannot convert document %d to json | %w", document, err)
+		}
+
+		if err = add(raw, document); err != nil {
+			return nil, err
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package reasons
+
+import (
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+)
+
+//go:generate go run ../../cmd/errgen -catalog catalog.yaml -out catalog_gen.go -doc ../docs/errors.md
+
+// Reason is the reason of the ErrorInfo details of an error. Reasons are declared in catalog.yaml,
+// which also gives their default code, HTTP status and retryability.
+type Reason string
+
+type definition struct {
+	code       codes.Code
+	httpStatus int
+	retryable  bool
+	title      string
+}
+
+// Known reports whether the reason is declared in the catalog.
+func (r Reason) Known() bool {
+	_, ok := catalog[r]
+
+	return ok
+}
+
+// Code is the default gRPC code of the reason, Unknown for reasons outside the catalog.
+func (r Reason) Code() codes.Code {
+	if definition, ok := catalog[r]; ok {
+		return definition.code
+	}
+
+	return codes.Unknown
+}
+
+// HTTPStatus is the status the gateway responds with, 500 for reasons outside the catalog.
+func (r Reason) HTTPStatus() int {
+	if definition, ok := catalog[r]; ok {
+		return definition.httpStatus
+	}
+
+	return http.StatusInternalServerError
+}
+
+// Retryable reports whether the same request may succeed later.
+func (r Reason) Retryable() bool {
+	return catalog[r].retryable
+}
+
+// Title summarizes the reason in a few words, empty for reasons outside the catalog.
+func (r Reason) Title() string {
+	return catalog[r].title
+}
+
+// All returns the reasons of the catalog in the order they are declared.
+func All() []Reason {
+	return append([]Reason(nil), all...)
+}
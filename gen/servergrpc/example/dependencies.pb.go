@@ -0,0 +1,610 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.1
+// 	protoc        v5.27.0
+// source: params/dependencies.proto
+
+package servergrpc
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DependencyDirection int32
+
+const (
+	// Same as DEPENDENCY_DIRECTION_UPSTREAM.
+	DependencyDirection_DEPENDENCY_DIRECTION_UNSPECIFIED DependencyDirection = 0
+	// Services the given one depends on, what it needs to work.
+	DependencyDirection_DEPENDENCY_DIRECTION_UPSTREAM DependencyDirection = 1
+	// Services that depend on the given one, what breaks if it goes down.
+	DependencyDirection_DEPENDENCY_DIRECTION_DOWNSTREAM DependencyDirection = 2
+)
+
+// Enum value maps for DependencyDirection.
+var (
+	DependencyDirection_name = map[int32]string{
+		0: "DEPENDENCY_DIRECTION_UNSPECIFIED",
+		1: "DEPENDENCY_DIRECTION_UPSTREAM",
+		2: "DEPENDENCY_DIRECTION_DOWNSTREAM",
+	}
+	DependencyDirection_value = map[string]int32{
+		"DEPENDENCY_DIRECTION_UNSPECIFIED": 0,
+		"DEPENDENCY_DIRECTION_UPSTREAM":    1,
+		"DEPENDENCY_DIRECTION_DOWNSTREAM":  2,
+	}
+)
+
+func (x DependencyDirection) Enum() *DependencyDirection {
+	p := new(DependencyDirection)
+	*p = x
+	return p
+}
+
+func (x DependencyDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DependencyDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_params_dependencies_proto_enumTypes[0].Descriptor()
+}
+
+func (DependencyDirection) Type() protoreflect.EnumType {
+	return &file_params_dependencies_proto_enumTypes[0]
+}
+
+func (x DependencyDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DependencyDirection.Descriptor instead.
+func (DependencyDirection) EnumDescriptor() ([]byte, []int) {
+	return file_params_dependencies_proto_rawDescGZIP(), []int{0}
+}
+
+type GraphFormat int32
+
+const (
+	// Same as GRAPH_FORMAT_JSON.
+	GraphFormat_GRAPH_FORMAT_UNSPECIFIED GraphFormat = 0
+	GraphFormat_GRAPH_FORMAT_JSON        GraphFormat = 1
+	GraphFormat_GRAPH_FORMAT_DOT         GraphFormat = 2
+)
+
+// Enum value maps for GraphFormat.
+var (
+	GraphFormat_name = map[int32]string{
+		0: "GRAPH_FORMAT_UNSPECIFIED",
+		1: "GRAPH_FORMAT_JSON",
+		2: "GRAPH_FORMAT_DOT",
+	}
+	GraphFormat_value = map[string]int32{
+		"GRAPH_FORMAT_UNSPECIFIED": 0,
+		"GRAPH_FORMAT_JSON":        1,
+		"GRAPH_FORMAT_DOT":         2,
+	}
+)
+
+func (x GraphFormat) Enum() *GraphFormat {
+	p := new(GraphFormat)
+	*p = x
+	return p
+}
+
+func (x GraphFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GraphFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_params_dependencies_proto_enumTypes[1].Descriptor()
+}
+
+func (GraphFormat) Type() protoreflect.EnumType {
+	return &file_params_dependencies_proto_enumTypes[1]
+}
+
+func (x GraphFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GraphFormat.Descriptor instead.
+func (GraphFormat) EnumDescriptor() ([]byte, []int) {
+	return file_params_dependencies_proto_rawDescGZIP(), []int{1}
+}
+
+type GetServiceDependenciesRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ServiceName string                 `protobuf:"bytes,1,opt,name=ServiceName,proto3" json:"ServiceName,omitempty"`
+	Direction   DependencyDirection    `protobuf:"varint,2,opt,name=Direction,proto3,enum=ingvarmattis.services.example.v1.DependencyDirection" json:"Direction,omitempty"`
+	// Maximum number of edges between ServiceName and a returned service. Zero means no limit.
+	MaxDepth      int32 `protobuf:"varint,3,opt,name=MaxDepth,proto3" json:"MaxDepth,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetServiceDependenciesRequest) Reset() {
+	*x = GetServiceDependenciesRequest{}
+	mi := &file_params_dependencies_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServiceDependenciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceDependenciesRequest) ProtoMessage() {}
+
+func (x *GetServiceDependenciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_params_dependencies_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceDependenciesRequest.ProtoReflect.Descriptor instead.
+func (*GetServiceDependenciesRequest) Descriptor() ([]byte, []int) {
+	return file_params_dependencies_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetServiceDependenciesRequest) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *GetServiceDependenciesRequest) GetDirection() DependencyDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return DependencyDirection_DEPENDENCY_DIRECTION_UNSPECIFIED
+}
+
+func (x *GetServiceDependenciesRequest) GetMaxDepth() int32 {
+	if x != nil {
+		return x.MaxDepth
+	}
+	return 0
+}
+
+type GetServiceDependenciesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Reachable services ordered by depth, ServiceName itself is not included.
+	Dependencies  []*DependencyNode `protobuf:"bytes,1,rep,name=Dependencies,proto3" json:"Dependencies,omitempty"`
+	Edges         []*DependencyEdge `protobuf:"bytes,2,rep,name=Edges,proto3" json:"Edges,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetServiceDependenciesResponse) Reset() {
+	*x = GetServiceDependenciesResponse{}
+	mi := &file_params_dependencies_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServiceDependenciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceDependenciesResponse) ProtoMessage() {}
+
+func (x *GetServiceDependenciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_params_dependencies_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceDependenciesResponse.ProtoReflect.Descriptor instead.
+func (*GetServiceDependenciesResponse) Descriptor() ([]byte, []int) {
+	return file_params_dependencies_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetServiceDependenciesResponse) GetDependencies() []*DependencyNode {
+	if x != nil {
+		return x.Dependencies
+	}
+	return nil
+}
+
+func (x *GetServiceDependenciesResponse) GetEdges() []*DependencyEdge {
+	if x != nil {
+		return x.Edges
+	}
+	return nil
+}
+
+type GetDependencyGraphRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Service to walk the graph from. Empty returns the graph of the whole registry.
+	ServiceName string              `protobuf:"bytes,1,opt,name=ServiceName,proto3" json:"ServiceName,omitempty"`
+	Direction   DependencyDirection `protobuf:"varint,2,opt,name=Direction,proto3,enum=ingvarmattis.services.example.v1.DependencyDirection" json:"Direction,omitempty"`
+	// Maximum number of edges between ServiceName and a returned service. Zero means no limit.
+	MaxDepth      int32       `protobuf:"varint,3,opt,name=MaxDepth,proto3" json:"MaxDepth,omitempty"`
+	Format        GraphFormat `protobuf:"varint,4,opt,name=Format,proto3,enum=ingvarmattis.services.example.v1.GraphFormat" json:"Format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDependencyGraphRequest) Reset() {
+	*x = GetDependencyGraphRequest{}
+	mi := &file_params_dependencies_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDependencyGraphRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDependencyGraphRequest) ProtoMessage() {}
+
+func (x *GetDependencyGraphRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_params_dependencies_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDependencyGraphRequest.ProtoReflect.Descriptor instead.
+func (*GetDependencyGraphRequest) Descriptor() ([]byte, []int) {
+	return file_params_dependencies_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetDependencyGraphRequest) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *GetDependencyGraphRequest) GetDirection() DependencyDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return DependencyDirection_DEPENDENCY_DIRECTION_UNSPECIFIED
+}
+
+func (x *GetDependencyGraphRequest) GetMaxDepth() int32 {
+	if x != nil {
+		return x.MaxDepth
+	}
+	return 0
+}
+
+func (x *GetDependencyGraphRequest) GetFormat() GraphFormat {
+	if x != nil {
+		return x.Format
+	}
+	return GraphFormat_GRAPH_FORMAT_UNSPECIFIED
+}
+
+type GetDependencyGraphResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Graph rendered in Format.
+	Graph string `protobuf:"bytes,1,opt,name=Graph,proto3" json:"Graph,omitempty"`
+	// Media type of Graph, application/json or text/vnd.graphviz.
+	ContentType   string `protobuf:"bytes,2,opt,name=ContentType,proto3" json:"ContentType,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDependencyGraphResponse) Reset() {
+	*x = GetDependencyGraphResponse{}
+	mi := &file_params_dependencies_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDependencyGraphResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDependencyGraphResponse) ProtoMessage() {}
+
+func (x *GetDependencyGraphResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_params_dependencies_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDependencyGraphResponse.ProtoReflect.Descriptor instead.
+func (*GetDependencyGraphResponse) Descriptor() ([]byte, []int) {
+	return file_params_dependencies_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetDependencyGraphResponse) GetGraph() string {
+	if x != nil {
+		return x.Graph
+	}
+	return ""
+}
+
+func (x *GetDependencyGraphResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+type DependencyNode struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ServiceName string                 `protobuf:"bytes,1,opt,name=ServiceName,proto3" json:"ServiceName,omitempty"`
+	// Number of edges between the node and the service the graph was walked from.
+	Depth         int32 `protobuf:"varint,2,opt,name=Depth,proto3" json:"Depth,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DependencyNode) Reset() {
+	*x = DependencyNode{}
+	mi := &file_params_dependencies_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DependencyNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DependencyNode) ProtoMessage() {}
+
+func (x *DependencyNode) ProtoReflect() protoreflect.Message {
+	mi := &file_params_dependencies_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DependencyNode.ProtoReflect.Descriptor instead.
+func (*DependencyNode) Descriptor() ([]byte, []int) {
+	return file_params_dependencies_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DependencyNode) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *DependencyNode) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+// DependencyEdge means ServiceName depends on DependsOn.
+type DependencyEdge struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServiceName   string                 `protobuf:"bytes,1,opt,name=ServiceName,proto3" json:"ServiceName,omitempty"`
+	DependsOn     string                 `protobuf:"bytes,2,opt,name=DependsOn,proto3" json:"DependsOn,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DependencyEdge) Reset() {
+	*x = DependencyEdge{}
+	mi := &file_params_dependencies_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DependencyEdge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DependencyEdge) ProtoMessage() {}
+
+func (x *DependencyEdge) ProtoReflect() protoreflect.Message {
+	mi := &file_params_dependencies_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DependencyEdge.ProtoReflect.Descriptor instead.
+func (*DependencyEdge) Descriptor() ([]byte, []int) {
+	return file_params_dependencies_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DependencyEdge) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *DependencyEdge) GetDependsOn() string {
+	if x != nil {
+		return x.DependsOn
+	}
+	return ""
+}
+
+var File_params_dependencies_proto protoreflect.FileDescriptor
+
+var file_params_dependencies_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2f, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65,
+	0x6e, 0x63, 0x69, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x20, 0x69, 0x6e, 0x67,
+	0x76, 0x61, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x69, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x22, 0xb2, 0x01,
+	0x0a, 0x1d, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x70, 0x65,
+	0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x20, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x53, 0x0a, 0x09, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e, 0x69, 0x6e, 0x67, 0x76, 0x61, 0x72, 0x6d, 0x61, 0x74,
+	0x74, 0x69, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x65, 0x78, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e,
+	0x63, 0x79, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x44, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x4d, 0x61, 0x78, 0x44, 0x65, 0x70,
+	0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x4d, 0x61, 0x78, 0x44, 0x65, 0x70,
+	0x74, 0x68, 0x22, 0xbe, 0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0c, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65,
+	0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x69, 0x6e,
+	0x67, 0x76, 0x61, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x69, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x0c, 0x44,
+	0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x46, 0x0a, 0x05, 0x45,
+	0x64, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x69, 0x6e, 0x67,
+	0x76, 0x61, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x69, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
+	0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x45, 0x64, 0x67, 0x65, 0x52, 0x05, 0x45, 0x64,
+	0x67, 0x65, 0x73, 0x22, 0xf5, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x44, 0x65, 0x70, 0x65, 0x6e,
+	0x64, 0x65, 0x6e, 0x63, 0x79, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x53, 0x0a, 0x09, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e, 0x69, 0x6e, 0x67, 0x76, 0x61, 0x72, 0x6d,
+	0x61, 0x74, 0x74, 0x69, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x65,
+	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64,
+	0x65, 0x6e, 0x63, 0x79, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x44,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x4d, 0x61, 0x78, 0x44,
+	0x65, 0x70, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x4d, 0x61, 0x78, 0x44,
+	0x65, 0x70, 0x74, 0x68, 0x12, 0x45, 0x0a, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x69, 0x6e, 0x67, 0x76, 0x61, 0x72, 0x6d, 0x61, 0x74,
+	0x74, 0x69, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x65, 0x78, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x61, 0x70, 0x68, 0x46, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x52, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x54, 0x0a, 0x1a, 0x47,
+	0x65, 0x74, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x47, 0x72, 0x61, 0x70,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x47, 0x72, 0x61,
+	0x70, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x47, 0x72, 0x61, 0x70, 0x68, 0x12,
+	0x20, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x22, 0x48, 0x0a, 0x0e, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x4e,
+	0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x44, 0x65, 0x70, 0x74, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x44, 0x65, 0x70, 0x74, 0x68, 0x22, 0x50, 0x0a, 0x0e, 0x44,
+	0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x45, 0x64, 0x67, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x73, 0x4f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x73, 0x4f, 0x6e, 0x2a, 0x83, 0x01,
+	0x0a, 0x13, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x44, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x20, 0x44, 0x45, 0x50, 0x45, 0x4e, 0x44, 0x45,
+	0x4e, 0x43, 0x59, 0x5f, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x44,
+	0x45, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x50, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x10, 0x01, 0x12, 0x23,
+	0x0a, 0x1f, 0x44, 0x45, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x44, 0x49, 0x52,
+	0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x4f, 0x57, 0x4e, 0x53, 0x54, 0x52, 0x45, 0x41,
+	0x4d, 0x10, 0x02, 0x2a, 0x58, 0x0a, 0x0b, 0x47, 0x72, 0x61, 0x70, 0x68, 0x46, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x12, 0x1c, 0x0a, 0x18, 0x47, 0x52, 0x41, 0x50, 0x48, 0x5f, 0x46, 0x4f, 0x52, 0x4d,
+	0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x15, 0x0a, 0x11, 0x47, 0x52, 0x41, 0x50, 0x48, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54,
+	0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x47, 0x52, 0x41, 0x50, 0x48,
+	0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x44, 0x4f, 0x54, 0x10, 0x02, 0x42, 0x25, 0x5a,
+	0x23, 0x2e, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x67, 0x72, 0x70,
+	0x63, 0x2f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x3b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x67, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_params_dependencies_proto_rawDescOnce sync.Once
+	file_params_dependencies_proto_rawDescData = file_params_dependencies_proto_rawDesc
+)
+
+func file_params_dependencies_proto_rawDescGZIP() []byte {
+	file_params_dependencies_proto_rawDescOnce.Do(func() {
+		file_params_dependencies_proto_rawDescData = protoimpl.X.CompressGZIP(file_params_dependencies_proto_rawDescData)
+	})
+	return file_params_dependencies_proto_rawDescData
+}
+
+var file_params_dependencies_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_params_dependencies_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_params_dependencies_proto_goTypes = []any{
+	(DependencyDirection)(0),               // 0: ingvarmattis.services.example.v1.DependencyDirection
+	(GraphFormat)(0),                       // 1: ingvarmattis.services.example.v1.GraphFormat
+	(*GetServiceDependenciesRequest)(nil),  // 2: ingvarmattis.services.example.v1.GetServiceDependenciesRequest
+	(*GetServiceDependenciesResponse)(nil), // 3: ingvarmattis.services.example.v1.GetServiceDependenciesResponse
+	(*GetDependencyGraphRequest)(nil),      // 4: ingvarmattis.services.example.v1.GetDependencyGraphRequest
+	(*GetDependencyGraphResponse)(nil),     // 5: ingvarmattis.services.example.v1.GetDependencyGraphResponse
+	(*DependencyNode)(nil),                 // 6: ingvarmattis.services.example.v1.DependencyNode
+	(*DependencyEdge)(nil),                 // 7: ingvarmattis.services.example.v1.DependencyEdge
+}
+var file_params_dependencies_proto_depIdxs = []int32{
+	0, // 0: ingvarmattis.services.example.v1.GetServiceDependenciesRequest.Direction:type_name -> ingvarmattis.services.example.v1.DependencyDirection
+	6, // 1: ingvarmattis.services.example.v1.GetServiceDependenciesResponse.Dependencies:type_name -> ingvarmattis.services.example.v1.DependencyNode
+	7, // 2: ingvarmattis.services.example.v1.GetServiceDependenciesResponse.Edges:type_name -> ingvarmattis.services.example.v1.DependencyEdge
+	0, // 3: ingvarmattis.services.example.v1.GetDependencyGraphRequest.Direction:type_name -> ingvarmattis.services.example.v1.DependencyDirection
+	1, // 4: ingvarmattis.services.example.v1.GetDependencyGraphRequest.Format:type_name -> ingvarmattis.services.example.v1.GraphFormat
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_params_dependencies_proto_init() }
+func file_params_dependencies_proto_init() {
+	if File_params_dependencies_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_params_dependencies_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_params_dependencies_proto_goTypes,
+		DependencyIndexes: file_params_dependencies_proto_depIdxs,
+		EnumInfos:         file_params_dependencies_proto_enumTypes,
+		MessageInfos:      file_params_dependencies_proto_msgTypes,
+	}.Build()
+	File_params_dependencies_proto = out.File
+	file_params_dependencies_proto_rawDesc = nil
+	file_params_dependencies_proto_goTypes = nil
+	file_params_dependencies_proto_depIdxs = nil
+}
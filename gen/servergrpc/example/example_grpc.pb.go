@@ -20,8 +20,25 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ExampleService_ServiceName_FullMethodName = "/ingvarmattis.services.example.v1.ExampleService/ServiceName"
-	ExampleService_Status_FullMethodName      = "/ingvarmattis.services.example.v1.ExampleService/Status"
+	ExampleService_ServiceName_FullMethodName            = "/ingvarmattis.services.example.v1.ExampleService/ServiceName"
+	ExampleService_Status_FullMethodName                 = "/ingvarmattis.services.example.v1.ExampleService/Status"
+	ExampleService_RegisterService_FullMethodName        = "/ingvarmattis.services.example.v1.ExampleService/RegisterService"
+	ExampleService_ListServices_FullMethodName           = "/ingvarmattis.services.example.v1.ExampleService/ListServices"
+	ExampleService_WatchServices_FullMethodName          = "/ingvarmattis.services.example.v1.ExampleService/WatchServices"
+	ExampleService_ExportServices_FullMethodName         = "/ingvarmattis.services.example.v1.ExampleService/ExportServices"
+	ExampleService_ImportServices_FullMethodName         = "/ingvarmattis.services.example.v1.ExampleService/ImportServices"
+	ExampleService_GetService_FullMethodName             = "/ingvarmattis.services.example.v1.ExampleService/GetService"
+	ExampleService_UpdateService_FullMethodName          = "/ingvarmattis.services.example.v1.ExampleService/UpdateService"
+	ExampleService_PatchService_FullMethodName           = "/ingvarmattis.services.example.v1.ExampleService/PatchService"
+	ExampleService_Heartbeat_FullMethodName              = "/ingvarmattis.services.example.v1.ExampleService/Heartbeat"
+	ExampleService_RegisterInstance_FullMethodName       = "/ingvarmattis.services.example.v1.ExampleService/RegisterInstance"
+	ExampleService_ListInstances_FullMethodName          = "/ingvarmattis.services.example.v1.ExampleService/ListInstances"
+	ExampleService_InstanceHeartbeat_FullMethodName      = "/ingvarmattis.services.example.v1.ExampleService/InstanceHeartbeat"
+	ExampleService_UnregisterInstance_FullMethodName     = "/ingvarmattis.services.example.v1.ExampleService/UnregisterInstance"
+	ExampleService_GetServiceHistory_FullMethodName      = "/ingvarmattis.services.example.v1.ExampleService/GetServiceHistory"
+	ExampleService_GetServiceDependencies_FullMethodName = "/ingvarmattis.services.example.v1.ExampleService/GetServiceDependencies"
+	ExampleService_GetDependencyGraph_FullMethodName     = "/ingvarmattis.services.example.v1.ExampleService/GetDependencyGraph"
+	ExampleService_UnregisterService_FullMethodName      = "/ingvarmattis.services.example.v1.ExampleService/UnregisterService"
 )
 
 // ExampleServiceClient is the client API for ExampleService service.
@@ -30,6 +47,23 @@ const (
 type ExampleServiceClient interface {
 	ServiceName(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ServiceNameResponse, error)
 	Status(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	RegisterService(ctx context.Context, in *RegisterServiceRequest, opts ...grpc.CallOption) (*RegisterServiceResponse, error)
+	ListServices(ctx context.Context, in *ListServicesRequest, opts ...grpc.CallOption) (*ListServicesResponse, error)
+	WatchServices(ctx context.Context, in *WatchServicesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchServicesResponse], error)
+	ExportServices(ctx context.Context, in *ExportServicesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportServicesResponse], error)
+	ImportServices(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportServicesRequest, ImportServicesResponse], error)
+	GetService(ctx context.Context, in *GetServiceRequest, opts ...grpc.CallOption) (*GetServiceResponse, error)
+	UpdateService(ctx context.Context, in *UpdateServiceRequest, opts ...grpc.CallOption) (*UpdateServiceResponse, error)
+	PatchService(ctx context.Context, in *PatchServiceRequest, opts ...grpc.CallOption) (*PatchServiceResponse, error)
+	Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*HeartbeatResponse, error)
+	RegisterInstance(ctx context.Context, in *RegisterInstanceRequest, opts ...grpc.CallOption) (*RegisterInstanceResponse, error)
+	ListInstances(ctx context.Context, in *ListInstancesRequest, opts ...grpc.CallOption) (*ListInstancesResponse, error)
+	InstanceHeartbeat(ctx context.Context, in *InstanceHeartbeatRequest, opts ...grpc.CallOption) (*InstanceHeartbeatResponse, error)
+	UnregisterInstance(ctx context.Context, in *UnregisterInstanceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetServiceHistory(ctx context.Context, in *GetServiceHistoryRequest, opts ...grpc.CallOption) (*GetServiceHistoryResponse, error)
+	GetServiceDependencies(ctx context.Context, in *GetServiceDependenciesRequest, opts ...grpc.CallOption) (*GetServiceDependenciesResponse, error)
+	GetDependencyGraph(ctx context.Context, in *GetDependencyGraphRequest, opts ...grpc.CallOption) (*GetDependencyGraphResponse, error)
+	UnregisterService(ctx context.Context, in *UnregisterServiceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type exampleServiceClient struct {
@@ -60,12 +94,220 @@ func (c *exampleServiceClient) Status(ctx context.Context, in *StatusRequest, op
 	return out, nil
 }
 
+func (c *exampleServiceClient) RegisterService(ctx context.Context, in *RegisterServiceRequest, opts ...grpc.CallOption) (*RegisterServiceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterServiceResponse)
+	err := c.cc.Invoke(ctx, ExampleService_RegisterService_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exampleServiceClient) ListServices(ctx context.Context, in *ListServicesRequest, opts ...grpc.CallOption) (*ListServicesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListServicesResponse)
+	err := c.cc.Invoke(ctx, ExampleService_ListServices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exampleServiceClient) WatchServices(ctx context.Context, in *WatchServicesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchServicesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ExampleService_ServiceDesc.Streams[0], ExampleService_WatchServices_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchServicesRequest, WatchServicesResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ExampleService_WatchServicesClient = grpc.ServerStreamingClient[WatchServicesResponse]
+
+func (c *exampleServiceClient) ExportServices(ctx context.Context, in *ExportServicesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportServicesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ExampleService_ServiceDesc.Streams[1], ExampleService_ExportServices_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportServicesRequest, ExportServicesResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ExampleService_ExportServicesClient = grpc.ServerStreamingClient[ExportServicesResponse]
+
+func (c *exampleServiceClient) ImportServices(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportServicesRequest, ImportServicesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ExampleService_ServiceDesc.Streams[2], ExampleService_ImportServices_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportServicesRequest, ImportServicesResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ExampleService_ImportServicesClient = grpc.ClientStreamingClient[ImportServicesRequest, ImportServicesResponse]
+
+func (c *exampleServiceClient) GetService(ctx context.Context, in *GetServiceRequest, opts ...grpc.CallOption) (*GetServiceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetServiceResponse)
+	err := c.cc.Invoke(ctx, ExampleService_GetService_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exampleServiceClient) UpdateService(ctx context.Context, in *UpdateServiceRequest, opts ...grpc.CallOption) (*UpdateServiceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateServiceResponse)
+	err := c.cc.Invoke(ctx, ExampleService_UpdateService_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exampleServiceClient) PatchService(ctx context.Context, in *PatchServiceRequest, opts ...grpc.CallOption) (*PatchServiceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PatchServiceResponse)
+	err := c.cc.Invoke(ctx, ExampleService_PatchService_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exampleServiceClient) Heartbeat(ctx context.Context, in *HeartbeatRequest, opts ...grpc.CallOption) (*HeartbeatResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HeartbeatResponse)
+	err := c.cc.Invoke(ctx, ExampleService_Heartbeat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exampleServiceClient) RegisterInstance(ctx context.Context, in *RegisterInstanceRequest, opts ...grpc.CallOption) (*RegisterInstanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterInstanceResponse)
+	err := c.cc.Invoke(ctx, ExampleService_RegisterInstance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exampleServiceClient) ListInstances(ctx context.Context, in *ListInstancesRequest, opts ...grpc.CallOption) (*ListInstancesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInstancesResponse)
+	err := c.cc.Invoke(ctx, ExampleService_ListInstances_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exampleServiceClient) InstanceHeartbeat(ctx context.Context, in *InstanceHeartbeatRequest, opts ...grpc.CallOption) (*InstanceHeartbeatResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InstanceHeartbeatResponse)
+	err := c.cc.Invoke(ctx, ExampleService_InstanceHeartbeat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exampleServiceClient) UnregisterInstance(ctx context.Context, in *UnregisterInstanceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ExampleService_UnregisterInstance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exampleServiceClient) GetServiceHistory(ctx context.Context, in *GetServiceHistoryRequest, opts ...grpc.CallOption) (*GetServiceHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetServiceHistoryResponse)
+	err := c.cc.Invoke(ctx, ExampleService_GetServiceHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exampleServiceClient) GetServiceDependencies(ctx context.Context, in *GetServiceDependenciesRequest, opts ...grpc.CallOption) (*GetServiceDependenciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetServiceDependenciesResponse)
+	err := c.cc.Invoke(ctx, ExampleService_GetServiceDependencies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exampleServiceClient) GetDependencyGraph(ctx context.Context, in *GetDependencyGraphRequest, opts ...grpc.CallOption) (*GetDependencyGraphResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDependencyGraphResponse)
+	err := c.cc.Invoke(ctx, ExampleService_GetDependencyGraph_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exampleServiceClient) UnregisterService(ctx context.Context, in *UnregisterServiceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ExampleService_UnregisterService_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ExampleServiceServer is the server API for ExampleService service.
 // All implementations must embed UnimplementedExampleServiceServer
 // for forward compatibility.
 type ExampleServiceServer interface {
 	ServiceName(context.Context, *emptypb.Empty) (*ServiceNameResponse, error)
 	Status(context.Context, *StatusRequest) (*StatusResponse, error)
+	RegisterService(context.Context, *RegisterServiceRequest) (*RegisterServiceResponse, error)
+	ListServices(context.Context, *ListServicesRequest) (*ListServicesResponse, error)
+	WatchServices(*WatchServicesRequest, grpc.ServerStreamingServer[WatchServicesResponse]) error
+	ExportServices(*ExportServicesRequest, grpc.ServerStreamingServer[ExportServicesResponse]) error
+	ImportServices(grpc.ClientStreamingServer[ImportServicesRequest, ImportServicesResponse]) error
+	GetService(context.Context, *GetServiceRequest) (*GetServiceResponse, error)
+	UpdateService(context.Context, *UpdateServiceRequest) (*UpdateServiceResponse, error)
+	PatchService(context.Context, *PatchServiceRequest) (*PatchServiceResponse, error)
+	Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatResponse, error)
+	RegisterInstance(context.Context, *RegisterInstanceRequest) (*RegisterInstanceResponse, error)
+	ListInstances(context.Context, *ListInstancesRequest) (*ListInstancesResponse, error)
+	InstanceHeartbeat(context.Context, *InstanceHeartbeatRequest) (*InstanceHeartbeatResponse, error)
+	UnregisterInstance(context.Context, *UnregisterInstanceRequest) (*emptypb.Empty, error)
+	GetServiceHistory(context.Context, *GetServiceHistoryRequest) (*GetServiceHistoryResponse, error)
+	GetServiceDependencies(context.Context, *GetServiceDependenciesRequest) (*GetServiceDependenciesResponse, error)
+	GetDependencyGraph(context.Context, *GetDependencyGraphRequest) (*GetDependencyGraphResponse, error)
+	UnregisterService(context.Context, *UnregisterServiceRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedExampleServiceServer()
 }
 
@@ -82,6 +324,57 @@ func (UnimplementedExampleServiceServer) ServiceName(context.Context, *emptypb.E
 func (UnimplementedExampleServiceServer) Status(context.Context, *StatusRequest) (*StatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
 }
+func (UnimplementedExampleServiceServer) RegisterService(context.Context, *RegisterServiceRequest) (*RegisterServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterService not implemented")
+}
+func (UnimplementedExampleServiceServer) ListServices(context.Context, *ListServicesRequest) (*ListServicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListServices not implemented")
+}
+func (UnimplementedExampleServiceServer) WatchServices(*WatchServicesRequest, grpc.ServerStreamingServer[WatchServicesResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchServices not implemented")
+}
+func (UnimplementedExampleServiceServer) ExportServices(*ExportServicesRequest, grpc.ServerStreamingServer[ExportServicesResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportServices not implemented")
+}
+func (UnimplementedExampleServiceServer) ImportServices(grpc.ClientStreamingServer[ImportServicesRequest, ImportServicesResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportServices not implemented")
+}
+func (UnimplementedExampleServiceServer) GetService(context.Context, *GetServiceRequest) (*GetServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetService not implemented")
+}
+func (UnimplementedExampleServiceServer) UpdateService(context.Context, *UpdateServiceRequest) (*UpdateServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateService not implemented")
+}
+func (UnimplementedExampleServiceServer) PatchService(context.Context, *PatchServiceRequest) (*PatchServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchService not implemented")
+}
+func (UnimplementedExampleServiceServer) Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Heartbeat not implemented")
+}
+func (UnimplementedExampleServiceServer) RegisterInstance(context.Context, *RegisterInstanceRequest) (*RegisterInstanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterInstance not implemented")
+}
+func (UnimplementedExampleServiceServer) ListInstances(context.Context, *ListInstancesRequest) (*ListInstancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInstances not implemented")
+}
+func (UnimplementedExampleServiceServer) InstanceHeartbeat(context.Context, *InstanceHeartbeatRequest) (*InstanceHeartbeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstanceHeartbeat not implemented")
+}
+func (UnimplementedExampleServiceServer) UnregisterInstance(context.Context, *UnregisterInstanceRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterInstance not implemented")
+}
+func (UnimplementedExampleServiceServer) GetServiceHistory(context.Context, *GetServiceHistoryRequest) (*GetServiceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServiceHistory not implemented")
+}
+func (UnimplementedExampleServiceServer) GetServiceDependencies(context.Context, *GetServiceDependenciesRequest) (*GetServiceDependenciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServiceDependencies not implemented")
+}
+func (UnimplementedExampleServiceServer) GetDependencyGraph(context.Context, *GetDependencyGraphRequest) (*GetDependencyGraphResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDependencyGraph not implemented")
+}
+func (UnimplementedExampleServiceServer) UnregisterService(context.Context, *UnregisterServiceRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterService not implemented")
+}
 func (UnimplementedExampleServiceServer) mustEmbedUnimplementedExampleServiceServer() {}
 func (UnimplementedExampleServiceServer) testEmbeddedByValue()                        {}
 
@@ -139,6 +432,287 @@ func _ExampleService_Status_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ExampleService_RegisterService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExampleServiceServer).RegisterService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExampleService_RegisterService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExampleServiceServer).RegisterService(ctx, req.(*RegisterServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExampleService_ListServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListServicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExampleServiceServer).ListServices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExampleService_ListServices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExampleServiceServer).ListServices(ctx, req.(*ListServicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExampleService_WatchServices_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchServicesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExampleServiceServer).WatchServices(m, &grpc.GenericServerStream[WatchServicesRequest, WatchServicesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ExampleService_WatchServicesServer = grpc.ServerStreamingServer[WatchServicesResponse]
+
+func _ExampleService_ExportServices_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportServicesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExampleServiceServer).ExportServices(m, &grpc.GenericServerStream[ExportServicesRequest, ExportServicesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ExampleService_ExportServicesServer = grpc.ServerStreamingServer[ExportServicesResponse]
+
+func _ExampleService_ImportServices_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ExampleServiceServer).ImportServices(&grpc.GenericServerStream[ImportServicesRequest, ImportServicesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ExampleService_ImportServicesServer = grpc.ClientStreamingServer[ImportServicesRequest, ImportServicesResponse]
+
+func _ExampleService_GetService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExampleServiceServer).GetService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExampleService_GetService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExampleServiceServer).GetService(ctx, req.(*GetServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExampleService_UpdateService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExampleServiceServer).UpdateService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExampleService_UpdateService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExampleServiceServer).UpdateService(ctx, req.(*UpdateServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExampleService_PatchService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExampleServiceServer).PatchService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExampleService_PatchService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExampleServiceServer).PatchService(ctx, req.(*PatchServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExampleService_Heartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeartbeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExampleServiceServer).Heartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExampleService_Heartbeat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExampleServiceServer).Heartbeat(ctx, req.(*HeartbeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExampleService_RegisterInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterInstanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExampleServiceServer).RegisterInstance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExampleService_RegisterInstance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExampleServiceServer).RegisterInstance(ctx, req.(*RegisterInstanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExampleService_ListInstances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInstancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExampleServiceServer).ListInstances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExampleService_ListInstances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExampleServiceServer).ListInstances(ctx, req.(*ListInstancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExampleService_InstanceHeartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstanceHeartbeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExampleServiceServer).InstanceHeartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExampleService_InstanceHeartbeat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExampleServiceServer).InstanceHeartbeat(ctx, req.(*InstanceHeartbeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExampleService_UnregisterInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnregisterInstanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExampleServiceServer).UnregisterInstance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExampleService_UnregisterInstance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExampleServiceServer).UnregisterInstance(ctx, req.(*UnregisterInstanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExampleService_GetServiceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServiceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExampleServiceServer).GetServiceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExampleService_GetServiceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExampleServiceServer).GetServiceHistory(ctx, req.(*GetServiceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExampleService_GetServiceDependencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServiceDependenciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExampleServiceServer).GetServiceDependencies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExampleService_GetServiceDependencies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExampleServiceServer).GetServiceDependencies(ctx, req.(*GetServiceDependenciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExampleService_GetDependencyGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDependencyGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExampleServiceServer).GetDependencyGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExampleService_GetDependencyGraph_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExampleServiceServer).GetDependencyGraph(ctx, req.(*GetDependencyGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExampleService_UnregisterService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnregisterServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExampleServiceServer).UnregisterService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExampleService_UnregisterService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExampleServiceServer).UnregisterService(ctx, req.(*UnregisterServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ExampleService_ServiceDesc is the grpc.ServiceDesc for ExampleService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -154,7 +728,79 @@ var ExampleService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Status",
 			Handler:    _ExampleService_Status_Handler,
 		},
+		{
+			MethodName: "RegisterService",
+			Handler:    _ExampleService_RegisterService_Handler,
+		},
+		{
+			MethodName: "ListServices",
+			Handler:    _ExampleService_ListServices_Handler,
+		},
+		{
+			MethodName: "GetService",
+			Handler:    _ExampleService_GetService_Handler,
+		},
+		{
+			MethodName: "UpdateService",
+			Handler:    _ExampleService_UpdateService_Handler,
+		},
+		{
+			MethodName: "PatchService",
+			Handler:    _ExampleService_PatchService_Handler,
+		},
+		{
+			MethodName: "Heartbeat",
+			Handler:    _ExampleService_Heartbeat_Handler,
+		},
+		{
+			MethodName: "RegisterInstance",
+			Handler:    _ExampleService_RegisterInstance_Handler,
+		},
+		{
+			MethodName: "ListInstances",
+			Handler:    _ExampleService_ListInstances_Handler,
+		},
+		{
+			MethodName: "InstanceHeartbeat",
+			Handler:    _ExampleService_InstanceHeartbeat_Handler,
+		},
+		{
+			MethodName: "UnregisterInstance",
+			Handler:    _ExampleService_UnregisterInstance_Handler,
+		},
+		{
+			MethodName: "GetServiceHistory",
+			Handler:    _ExampleService_GetServiceHistory_Handler,
+		},
+		{
+			MethodName: "GetServiceDependencies",
+			Handler:    _ExampleService_GetServiceDependencies_Handler,
+		},
+		{
+			MethodName: "GetDependencyGraph",
+			Handler:    _ExampleService_GetDependencyGraph_Handler,
+		},
+		{
+			MethodName: "UnregisterService",
+			Handler:    _ExampleService_UnregisterService_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchServices",
+			Handler:       _ExampleService_WatchServices_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportServices",
+			Handler:       _ExampleService_ExportServices_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportServices",
+			Handler:       _ExampleService_ImportServices_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "example.proto",
 }
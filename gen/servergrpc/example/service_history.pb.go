@@ -0,0 +1,467 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.1
+// 	protoc        v5.27.0
+// source: params/service_history.proto
+
+package servergrpc
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ServiceHistoryEventType int32
+
+const (
+	ServiceHistoryEventType_SERVICE_HISTORY_EVENT_TYPE_UNSPECIFIED ServiceHistoryEventType = 0
+	ServiceHistoryEventType_SERVICE_HISTORY_EVENT_TYPE_REGISTERED  ServiceHistoryEventType = 1
+	ServiceHistoryEventType_SERVICE_HISTORY_EVENT_TYPE_UPDATED     ServiceHistoryEventType = 2
+	// Recorded in the history of both the old and the new name.
+	ServiceHistoryEventType_SERVICE_HISTORY_EVENT_TYPE_RENAMED ServiceHistoryEventType = 3
+	// The registration missed its lease.
+	ServiceHistoryEventType_SERVICE_HISTORY_EVENT_TYPE_EXPIRED ServiceHistoryEventType = 4
+	// An expired registration sent a heartbeat again.
+	ServiceHistoryEventType_SERVICE_HISTORY_EVENT_TYPE_REVIVED      ServiceHistoryEventType = 5
+	ServiceHistoryEventType_SERVICE_HISTORY_EVENT_TYPE_UNREGISTERED ServiceHistoryEventType = 6
+	// An expired registration was removed from the registry.
+	ServiceHistoryEventType_SERVICE_HISTORY_EVENT_TYPE_DELETED ServiceHistoryEventType = 7
+)
+
+// Enum value maps for ServiceHistoryEventType.
+var (
+	ServiceHistoryEventType_name = map[int32]string{
+		0: "SERVICE_HISTORY_EVENT_TYPE_UNSPECIFIED",
+		1: "SERVICE_HISTORY_EVENT_TYPE_REGISTERED",
+		2: "SERVICE_HISTORY_EVENT_TYPE_UPDATED",
+		3: "SERVICE_HISTORY_EVENT_TYPE_RENAMED",
+		4: "SERVICE_HISTORY_EVENT_TYPE_EXPIRED",
+		5: "SERVICE_HISTORY_EVENT_TYPE_REVIVED",
+		6: "SERVICE_HISTORY_EVENT_TYPE_UNREGISTERED",
+		7: "SERVICE_HISTORY_EVENT_TYPE_DELETED",
+	}
+	ServiceHistoryEventType_value = map[string]int32{
+		"SERVICE_HISTORY_EVENT_TYPE_UNSPECIFIED":  0,
+		"SERVICE_HISTORY_EVENT_TYPE_REGISTERED":   1,
+		"SERVICE_HISTORY_EVENT_TYPE_UPDATED":      2,
+		"SERVICE_HISTORY_EVENT_TYPE_RENAMED":      3,
+		"SERVICE_HISTORY_EVENT_TYPE_EXPIRED":      4,
+		"SERVICE_HISTORY_EVENT_TYPE_REVIVED":      5,
+		"SERVICE_HISTORY_EVENT_TYPE_UNREGISTERED": 6,
+		"SERVICE_HISTORY_EVENT_TYPE_DELETED":      7,
+	}
+)
+
+func (x ServiceHistoryEventType) Enum() *ServiceHistoryEventType {
+	p := new(ServiceHistoryEventType)
+	*p = x
+	return p
+}
+
+func (x ServiceHistoryEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ServiceHistoryEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_params_service_history_proto_enumTypes[0].Descriptor()
+}
+
+func (ServiceHistoryEventType) Type() protoreflect.EnumType {
+	return &file_params_service_history_proto_enumTypes[0]
+}
+
+func (x ServiceHistoryEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ServiceHistoryEventType.Descriptor instead.
+func (ServiceHistoryEventType) EnumDescriptor() ([]byte, []int) {
+	return file_params_service_history_proto_rawDescGZIP(), []int{0}
+}
+
+type GetServiceHistoryRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ServiceName string                 `protobuf:"bytes,1,opt,name=ServiceName,proto3" json:"ServiceName,omitempty"`
+	// Inclusive lower bound of OccurredAt, unset for the beginning of the history.
+	From *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=From,proto3" json:"From,omitempty"`
+	// Exclusive upper bound of OccurredAt, unset for no upper bound.
+	To *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=To,proto3" json:"To,omitempty"`
+	// Maximum number of events to return. Defaults to 50, capped at 1000.
+	PageSize int32 `protobuf:"varint,4,opt,name=PageSize,proto3" json:"PageSize,omitempty"`
+	// Opaque token returned as NextPageToken by a previous call with the same ServiceName, From and To.
+	PageToken     string `protobuf:"bytes,5,opt,name=PageToken,proto3" json:"PageToken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetServiceHistoryRequest) Reset() {
+	*x = GetServiceHistoryRequest{}
+	mi := &file_params_service_history_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServiceHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceHistoryRequest) ProtoMessage() {}
+
+func (x *GetServiceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_params_service_history_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetServiceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_params_service_history_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetServiceHistoryRequest) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *GetServiceHistoryRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetServiceHistoryRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetServiceHistoryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetServiceHistoryRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetServiceHistoryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Events in the order they happened.
+	Events        []*ServiceHistoryEvent `protobuf:"bytes,1,rep,name=Events,proto3" json:"Events,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=NextPageToken,proto3" json:"NextPageToken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetServiceHistoryResponse) Reset() {
+	*x = GetServiceHistoryResponse{}
+	mi := &file_params_service_history_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServiceHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceHistoryResponse) ProtoMessage() {}
+
+func (x *GetServiceHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_params_service_history_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetServiceHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_params_service_history_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetServiceHistoryResponse) GetEvents() []*ServiceHistoryEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *GetServiceHistoryResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ServiceHistoryEvent struct {
+	state       protoimpl.MessageState  `protogen:"open.v1"`
+	Id          int64                   `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	ServiceName string                  `protobuf:"bytes,2,opt,name=ServiceName,proto3" json:"ServiceName,omitempty"`
+	Type        ServiceHistoryEventType `protobuf:"varint,3,opt,name=Type,proto3,enum=ingvarmattis.services.example.v1.ServiceHistoryEventType" json:"Type,omitempty"`
+	// Caller that made the change, taken from the x-actor header or the peer address,
+	// or system:<component> for changes made by the registry itself.
+	Actor string `protobuf:"bytes,4,opt,name=Actor,proto3" json:"Actor,omitempty"`
+	// Registry record before the change, unset for SERVICE_HISTORY_EVENT_TYPE_REGISTERED.
+	OldValue *Service `protobuf:"bytes,5,opt,name=OldValue,proto3" json:"OldValue,omitempty"`
+	// Registry record after the change, unset once the service is gone.
+	NewValue      *Service               `protobuf:"bytes,6,opt,name=NewValue,proto3" json:"NewValue,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=OccurredAt,proto3" json:"OccurredAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceHistoryEvent) Reset() {
+	*x = ServiceHistoryEvent{}
+	mi := &file_params_service_history_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceHistoryEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceHistoryEvent) ProtoMessage() {}
+
+func (x *ServiceHistoryEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_params_service_history_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceHistoryEvent.ProtoReflect.Descriptor instead.
+func (*ServiceHistoryEvent) Descriptor() ([]byte, []int) {
+	return file_params_service_history_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ServiceHistoryEvent) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ServiceHistoryEvent) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *ServiceHistoryEvent) GetType() ServiceHistoryEventType {
+	if x != nil {
+		return x.Type
+	}
+	return ServiceHistoryEventType_SERVICE_HISTORY_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *ServiceHistoryEvent) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *ServiceHistoryEvent) GetOldValue() *Service {
+	if x != nil {
+		return x.OldValue
+	}
+	return nil
+}
+
+func (x *ServiceHistoryEvent) GetNewValue() *Service {
+	if x != nil {
+		return x.NewValue
+	}
+	return nil
+}
+
+func (x *ServiceHistoryEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+var File_params_service_history_proto protoreflect.FileDescriptor
+
+var file_params_service_history_proto_rawDesc = []byte{
+	0x0a, 0x1c, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x20,
+	0x69, 0x6e, 0x67, 0x76, 0x61, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x69, 0x73, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x14, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd2, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x54, 0x6f, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02,
+	0x54, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x90, 0x01, 0x0a,
+	0x19, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x06, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x69, 0x6e, 0x67,
+	0x76, 0x61, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x69, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x06, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x4e, 0x65, 0x78,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0xf6, 0x02, 0x0a, 0x13, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x4d, 0x0a, 0x04, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x39, 0x2e, 0x69, 0x6e, 0x67, 0x76, 0x61, 0x72,
+	0x6d, 0x61, 0x74, 0x74, 0x69, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x41, 0x63, 0x74, 0x6f,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x45,
+	0x0a, 0x08, 0x4f, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x29, 0x2e, 0x69, 0x6e, 0x67, 0x76, 0x61, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x69, 0x73, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x08, 0x4f, 0x6c, 0x64,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x45, 0x0a, 0x08, 0x4e, 0x65, 0x77, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x6e, 0x67, 0x76, 0x61, 0x72,
+	0x6d, 0x61, 0x74, 0x74, 0x69, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x52, 0x08, 0x4e, 0x65, 0x77, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x3a, 0x0a, 0x0a,
+	0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x4f, 0x63,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x41, 0x74, 0x2a, 0xe5, 0x02, 0x0a, 0x17, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x2a, 0x0a, 0x26, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f,
+	0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x29, 0x0a, 0x25, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x48, 0x49, 0x53, 0x54,
+	0x4f, 0x52, 0x59, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52,
+	0x45, 0x47, 0x49, 0x53, 0x54, 0x45, 0x52, 0x45, 0x44, 0x10, 0x01, 0x12, 0x26, 0x0a, 0x22, 0x53,
+	0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45,
+	0x44, 0x10, 0x02, 0x12, 0x26, 0x0a, 0x22, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x48,
+	0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x52, 0x45, 0x4e, 0x41, 0x4d, 0x45, 0x44, 0x10, 0x03, 0x12, 0x26, 0x0a, 0x22, 0x53,
+	0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45,
+	0x44, 0x10, 0x04, 0x12, 0x26, 0x0a, 0x22, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x48,
+	0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x52, 0x45, 0x56, 0x49, 0x56, 0x45, 0x44, 0x10, 0x05, 0x12, 0x2b, 0x0a, 0x27, 0x53,
+	0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x52, 0x45, 0x47, 0x49,
+	0x53, 0x54, 0x45, 0x52, 0x45, 0x44, 0x10, 0x06, 0x12, 0x26, 0x0a, 0x22, 0x53, 0x45, 0x52, 0x56,
+	0x49, 0x43, 0x45, 0x5f, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x07,
+	0x42, 0x25, 0x5a, 0x23, 0x2e, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x67, 0x72, 0x70, 0x63, 0x2f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x3b, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x67, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_params_service_history_proto_rawDescOnce sync.Once
+	file_params_service_history_proto_rawDescData = file_params_service_history_proto_rawDesc
+)
+
+func file_params_service_history_proto_rawDescGZIP() []byte {
+	file_params_service_history_proto_rawDescOnce.Do(func() {
+		file_params_service_history_proto_rawDescData = protoimpl.X.CompressGZIP(file_params_service_history_proto_rawDescData)
+	})
+	return file_params_service_history_proto_rawDescData
+}
+
+var file_params_service_history_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_params_service_history_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_params_service_history_proto_goTypes = []any{
+	(ServiceHistoryEventType)(0),      // 0: ingvarmattis.services.example.v1.ServiceHistoryEventType
+	(*GetServiceHistoryRequest)(nil),  // 1: ingvarmattis.services.example.v1.GetServiceHistoryRequest
+	(*GetServiceHistoryResponse)(nil), // 2: ingvarmattis.services.example.v1.GetServiceHistoryResponse
+	(*ServiceHistoryEvent)(nil),       // 3: ingvarmattis.services.example.v1.ServiceHistoryEvent
+	(*timestamppb.Timestamp)(nil),     // 4: google.protobuf.Timestamp
+	(*Service)(nil),                   // 5: ingvarmattis.services.example.v1.Service
+}
+var file_params_service_history_proto_depIdxs = []int32{
+	4, // 0: ingvarmattis.services.example.v1.GetServiceHistoryRequest.From:type_name -> google.protobuf.Timestamp
+	4, // 1: ingvarmattis.services.example.v1.GetServiceHistoryRequest.To:type_name -> google.protobuf.Timestamp
+	3, // 2: ingvarmattis.services.example.v1.GetServiceHistoryResponse.Events:type_name -> ingvarmattis.services.example.v1.ServiceHistoryEvent
+	0, // 3: ingvarmattis.services.example.v1.ServiceHistoryEvent.Type:type_name -> ingvarmattis.services.example.v1.ServiceHistoryEventType
+	5, // 4: ingvarmattis.services.example.v1.ServiceHistoryEvent.OldValue:type_name -> ingvarmattis.services.example.v1.Service
+	5, // 5: ingvarmattis.services.example.v1.ServiceHistoryEvent.NewValue:type_name -> ingvarmattis.services.example.v1.Service
+	4, // 6: ingvarmattis.services.example.v1.ServiceHistoryEvent.OccurredAt:type_name -> google.protobuf.Timestamp
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_params_service_history_proto_init() }
+func file_params_service_history_proto_init() {
+	if File_params_service_history_proto != nil {
+		return
+	}
+	file_params_service_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_params_service_history_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_params_service_history_proto_goTypes,
+		DependencyIndexes: file_params_service_history_proto_depIdxs,
+		EnumInfos:         file_params_service_history_proto_enumTypes,
+		MessageInfos:      file_params_service_history_proto_msgTypes,
+	}.Build()
+	File_params_service_history_proto = out.File
+	file_params_service_history_proto_rawDesc = nil
+	file_params_service_history_proto_goTypes = nil
+	file_params_service_history_proto_depIdxs = nil
+}
@@ -0,0 +1,704 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.1
+// 	protoc        v5.27.0
+// source: params/transfer.proto
+
+package servergrpc
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ImportConflictMode decides what happens to services registered with metadata other than imported.
+// Services registered exactly as imported are never a conflict.
+type ImportConflictMode int32
+
+const (
+	// Same as IMPORT_CONFLICT_MODE_FAIL.
+	ImportConflictMode_IMPORT_CONFLICT_MODE_UNSPECIFIED ImportConflictMode = 0
+	// Rejects the whole import with ALREADY_EXISTS.
+	ImportConflictMode_IMPORT_CONFLICT_MODE_FAIL ImportConflictMode = 1
+	// Keeps the registered services.
+	ImportConflictMode_IMPORT_CONFLICT_MODE_SKIP ImportConflictMode = 2
+	// Replaces the registered services.
+	ImportConflictMode_IMPORT_CONFLICT_MODE_UPSERT ImportConflictMode = 3
+)
+
+// Enum value maps for ImportConflictMode.
+var (
+	ImportConflictMode_name = map[int32]string{
+		0: "IMPORT_CONFLICT_MODE_UNSPECIFIED",
+		1: "IMPORT_CONFLICT_MODE_FAIL",
+		2: "IMPORT_CONFLICT_MODE_SKIP",
+		3: "IMPORT_CONFLICT_MODE_UPSERT",
+	}
+	ImportConflictMode_value = map[string]int32{
+		"IMPORT_CONFLICT_MODE_UNSPECIFIED": 0,
+		"IMPORT_CONFLICT_MODE_FAIL":        1,
+		"IMPORT_CONFLICT_MODE_SKIP":        2,
+		"IMPORT_CONFLICT_MODE_UPSERT":      3,
+	}
+)
+
+func (x ImportConflictMode) Enum() *ImportConflictMode {
+	p := new(ImportConflictMode)
+	*p = x
+	return p
+}
+
+func (x ImportConflictMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportConflictMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_params_transfer_proto_enumTypes[0].Descriptor()
+}
+
+func (ImportConflictMode) Type() protoreflect.EnumType {
+	return &file_params_transfer_proto_enumTypes[0]
+}
+
+func (x ImportConflictMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportConflictMode.Descriptor instead.
+func (ImportConflictMode) EnumDescriptor() ([]byte, []int) {
+	return file_params_transfer_proto_rawDescGZIP(), []int{0}
+}
+
+type ImportAction int32
+
+const (
+	ImportAction_IMPORT_ACTION_UNSPECIFIED ImportAction = 0
+	ImportAction_IMPORT_ACTION_CREATE      ImportAction = 1
+	ImportAction_IMPORT_ACTION_UPDATE      ImportAction = 2
+	ImportAction_IMPORT_ACTION_UNCHANGED   ImportAction = 3
+	ImportAction_IMPORT_ACTION_SKIP        ImportAction = 4
+)
+
+// Enum value maps for ImportAction.
+var (
+	ImportAction_name = map[int32]string{
+		0: "IMPORT_ACTION_UNSPECIFIED",
+		1: "IMPORT_ACTION_CREATE",
+		2: "IMPORT_ACTION_UPDATE",
+		3: "IMPORT_ACTION_UNCHANGED",
+		4: "IMPORT_ACTION_SKIP",
+	}
+	ImportAction_value = map[string]int32{
+		"IMPORT_ACTION_UNSPECIFIED": 0,
+		"IMPORT_ACTION_CREATE":      1,
+		"IMPORT_ACTION_UPDATE":      2,
+		"IMPORT_ACTION_UNCHANGED":   3,
+		"IMPORT_ACTION_SKIP":        4,
+	}
+)
+
+func (x ImportAction) Enum() *ImportAction {
+	p := new(ImportAction)
+	*p = x
+	return p
+}
+
+func (x ImportAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_params_transfer_proto_enumTypes[1].Descriptor()
+}
+
+func (ImportAction) Type() protoreflect.EnumType {
+	return &file_params_transfer_proto_enumTypes[1]
+}
+
+func (x ImportAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportAction.Descriptor instead.
+func (ImportAction) EnumDescriptor() ([]byte, []int) {
+	return file_params_transfer_proto_rawDescGZIP(), []int{1}
+}
+
+type ExportServicesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Adds the audit trail of the whole registry, including removed services, after the services.
+	IncludeHistory bool `protobuf:"varint,1,opt,name=IncludeHistory,proto3" json:"IncludeHistory,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ExportServicesRequest) Reset() {
+	*x = ExportServicesRequest{}
+	mi := &file_params_transfer_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportServicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportServicesRequest) ProtoMessage() {}
+
+func (x *ExportServicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_params_transfer_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportServicesRequest.ProtoReflect.Descriptor instead.
+func (*ExportServicesRequest) Descriptor() ([]byte, []int) {
+	return file_params_transfer_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ExportServicesRequest) GetIncludeHistory() bool {
+	if x != nil {
+		return x.IncludeHistory
+	}
+	return false
+}
+
+// ExportServicesResponse is a single record of an export. The same records are sent back to ImportServices.
+type ExportServicesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Record:
+	//
+	//	*ExportServicesResponse_Service
+	//	*ExportServicesResponse_Event
+	Record        isExportServicesResponse_Record `protobuf_oneof:"Record"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportServicesResponse) Reset() {
+	*x = ExportServicesResponse{}
+	mi := &file_params_transfer_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportServicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportServicesResponse) ProtoMessage() {}
+
+func (x *ExportServicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_params_transfer_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportServicesResponse.ProtoReflect.Descriptor instead.
+func (*ExportServicesResponse) Descriptor() ([]byte, []int) {
+	return file_params_transfer_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ExportServicesResponse) GetRecord() isExportServicesResponse_Record {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+func (x *ExportServicesResponse) GetService() *Service {
+	if x != nil {
+		if x, ok := x.Record.(*ExportServicesResponse_Service); ok {
+			return x.Service
+		}
+	}
+	return nil
+}
+
+func (x *ExportServicesResponse) GetEvent() *ServiceHistoryEvent {
+	if x != nil {
+		if x, ok := x.Record.(*ExportServicesResponse_Event); ok {
+			return x.Event
+		}
+	}
+	return nil
+}
+
+type isExportServicesResponse_Record interface {
+	isExportServicesResponse_Record()
+}
+
+type ExportServicesResponse_Service struct {
+	Service *Service `protobuf:"bytes,1,opt,name=Service,proto3,oneof"`
+}
+
+type ExportServicesResponse_Event struct {
+	Event *ServiceHistoryEvent `protobuf:"bytes,2,opt,name=Event,proto3,oneof"`
+}
+
+func (*ExportServicesResponse_Service) isExportServicesResponse_Record() {}
+
+func (*ExportServicesResponse_Event) isExportServicesResponse_Record() {}
+
+type ImportServicesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Read from the first message of the stream only.
+	Options *ImportOptions `protobuf:"bytes,1,opt,name=Options,proto3" json:"Options,omitempty"`
+	// Types that are valid to be assigned to Record:
+	//
+	//	*ImportServicesRequest_Service
+	//	*ImportServicesRequest_Event
+	Record        isImportServicesRequest_Record `protobuf_oneof:"Record"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportServicesRequest) Reset() {
+	*x = ImportServicesRequest{}
+	mi := &file_params_transfer_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportServicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportServicesRequest) ProtoMessage() {}
+
+func (x *ImportServicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_params_transfer_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportServicesRequest.ProtoReflect.Descriptor instead.
+func (*ImportServicesRequest) Descriptor() ([]byte, []int) {
+	return file_params_transfer_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ImportServicesRequest) GetOptions() *ImportOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *ImportServicesRequest) GetRecord() isImportServicesRequest_Record {
+	if x != nil {
+		return x.Record
+	}
+	return nil
+}
+
+func (x *ImportServicesRequest) GetService() *Service {
+	if x != nil {
+		if x, ok := x.Record.(*ImportServicesRequest_Service); ok {
+			return x.Service
+		}
+	}
+	return nil
+}
+
+func (x *ImportServicesRequest) GetEvent() *ServiceHistoryEvent {
+	if x != nil {
+		if x, ok := x.Record.(*ImportServicesRequest_Event); ok {
+			return x.Event
+		}
+	}
+	return nil
+}
+
+type isImportServicesRequest_Record interface {
+	isImportServicesRequest_Record()
+}
+
+type ImportServicesRequest_Service struct {
+	// Read-only fields such as CreatedAt and ResourceVersion are ignored.
+	Service *Service `protobuf:"bytes,2,opt,name=Service,proto3,oneof"`
+}
+
+type ImportServicesRequest_Event struct {
+	// Appended to the audit trail with its original Actor and OccurredAt, Id is assigned anew.
+	Event *ServiceHistoryEvent `protobuf:"bytes,3,opt,name=Event,proto3,oneof"`
+}
+
+func (*ImportServicesRequest_Service) isImportServicesRequest_Record() {}
+
+func (*ImportServicesRequest_Event) isImportServicesRequest_Record() {}
+
+type ImportOptions struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	ConflictMode ImportConflictMode     `protobuf:"varint,1,opt,name=ConflictMode,proto3,enum=ingvarmattis.services.example.v1.ImportConflictMode" json:"ConflictMode,omitempty"`
+	// Reports what the import would do without changing anything.
+	DryRun        bool `protobuf:"varint,2,opt,name=DryRun,proto3" json:"DryRun,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportOptions) Reset() {
+	*x = ImportOptions{}
+	mi := &file_params_transfer_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportOptions) ProtoMessage() {}
+
+func (x *ImportOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_params_transfer_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportOptions.ProtoReflect.Descriptor instead.
+func (*ImportOptions) Descriptor() ([]byte, []int) {
+	return file_params_transfer_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ImportOptions) GetConflictMode() ImportConflictMode {
+	if x != nil {
+		return x.ConflictMode
+	}
+	return ImportConflictMode_IMPORT_CONFLICT_MODE_UNSPECIFIED
+}
+
+func (x *ImportOptions) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ImportServicesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// An entry per imported service, in the order they were sent.
+	Diff           []*ImportDiff `protobuf:"bytes,1,rep,name=Diff,proto3" json:"Diff,omitempty"`
+	EventsImported int32         `protobuf:"varint,2,opt,name=EventsImported,proto3" json:"EventsImported,omitempty"`
+	// Set when nothing has actually been changed.
+	DryRun        bool `protobuf:"varint,3,opt,name=DryRun,proto3" json:"DryRun,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportServicesResponse) Reset() {
+	*x = ImportServicesResponse{}
+	mi := &file_params_transfer_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportServicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportServicesResponse) ProtoMessage() {}
+
+func (x *ImportServicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_params_transfer_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportServicesResponse.ProtoReflect.Descriptor instead.
+func (*ImportServicesResponse) Descriptor() ([]byte, []int) {
+	return file_params_transfer_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ImportServicesResponse) GetDiff() []*ImportDiff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *ImportServicesResponse) GetEventsImported() int32 {
+	if x != nil {
+		return x.EventsImported
+	}
+	return 0
+}
+
+func (x *ImportServicesResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ImportDiff struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ServiceName string                 `protobuf:"bytes,1,opt,name=ServiceName,proto3" json:"ServiceName,omitempty"`
+	Action      ImportAction           `protobuf:"varint,2,opt,name=Action,proto3,enum=ingvarmattis.services.example.v1.ImportAction" json:"Action,omitempty"`
+	// Fields that differ from the registered service, e.g. "OwnerTeam" or "Ttl".
+	ChangedFields []string `protobuf:"bytes,3,rep,name=ChangedFields,proto3" json:"ChangedFields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportDiff) Reset() {
+	*x = ImportDiff{}
+	mi := &file_params_transfer_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportDiff) ProtoMessage() {}
+
+func (x *ImportDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_params_transfer_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportDiff.ProtoReflect.Descriptor instead.
+func (*ImportDiff) Descriptor() ([]byte, []int) {
+	return file_params_transfer_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ImportDiff) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *ImportDiff) GetAction() ImportAction {
+	if x != nil {
+		return x.Action
+	}
+	return ImportAction_IMPORT_ACTION_UNSPECIFIED
+}
+
+func (x *ImportDiff) GetChangedFields() []string {
+	if x != nil {
+		return x.ChangedFields
+	}
+	return nil
+}
+
+var File_params_transfer_proto protoreflect.FileDescriptor
+
+var file_params_transfer_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x20, 0x69, 0x6e, 0x67, 0x76, 0x61, 0x72, 0x6d,
+	0x61, 0x74, 0x74, 0x69, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x65,
+	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x14, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1c, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x3f, 0x0a,
+	0x15, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
+	0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0xb8,
+	0x01, 0x0a, 0x16, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x07, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x6e, 0x67,
+	0x76, 0x61, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x69, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x48, 0x00, 0x52, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x4d, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x35, 0x2e, 0x69, 0x6e, 0x67, 0x76, 0x61, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x69, 0x73, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42,
+	0x08, 0x0a, 0x06, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x22, 0x82, 0x02, 0x0a, 0x15, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x49, 0x0a, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x6e, 0x67, 0x76, 0x61, 0x72, 0x6d, 0x61, 0x74,
+	0x74, 0x69, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x65, 0x78, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x45,
+	0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x29, 0x2e, 0x69, 0x6e, 0x67, 0x76, 0x61, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x69, 0x73, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x48, 0x00, 0x52, 0x07, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4d, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x69, 0x6e, 0x67, 0x76, 0x61, 0x72, 0x6d, 0x61, 0x74,
+	0x74, 0x69, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x65, 0x78, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x05, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x22, 0x81,
+	0x01, 0x0a, 0x0d, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x58, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x4d, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x34, 0x2e, 0x69, 0x6e, 0x67, 0x76, 0x61, 0x72, 0x6d,
+	0x61, 0x74, 0x74, 0x69, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x65,
+	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0c, 0x43, 0x6f,
+	0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x44, 0x72,
+	0x79, 0x52, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x44, 0x72, 0x79, 0x52,
+	0x75, 0x6e, 0x22, 0x9a, 0x01, 0x0a, 0x16, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a,
+	0x04, 0x44, 0x69, 0x66, 0x66, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x6e,
+	0x67, 0x76, 0x61, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x69, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x66, 0x66, 0x52, 0x04, 0x44, 0x69, 0x66, 0x66, 0x12,
+	0x26, 0x0a, 0x0e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x44, 0x72, 0x79, 0x52, 0x75,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x44, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22,
+	0x9c, 0x01, 0x0a, 0x0a, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x66, 0x66, 0x12, 0x20,
+	0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x46, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x2e, 0x2e, 0x69, 0x6e, 0x67, 0x76, 0x61, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x69, 0x73, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x64, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0d, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x2a, 0x99,
+	0x01, 0x0a, 0x12, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63,
+	0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x24, 0x0a, 0x20, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f,
+	0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x49,
+	0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x5f, 0x4d,
+	0x4f, 0x44, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x49, 0x4d,
+	0x50, 0x4f, 0x52, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x5f, 0x4d, 0x4f,
+	0x44, 0x45, 0x5f, 0x53, 0x4b, 0x49, 0x50, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x49, 0x4d, 0x50,
+	0x4f, 0x52, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x5f, 0x4d, 0x4f, 0x44,
+	0x45, 0x5f, 0x55, 0x50, 0x53, 0x45, 0x52, 0x54, 0x10, 0x03, 0x2a, 0x96, 0x01, 0x0a, 0x0c, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x19, 0x49,
+	0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x49, 0x4d,
+	0x50, 0x4f, 0x52, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x52, 0x45, 0x41,
+	0x54, 0x45, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x41,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x02, 0x12, 0x1b,
+	0x0a, 0x17, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x55, 0x4e, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x44, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x49,
+	0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4b, 0x49,
+	0x50, 0x10, 0x04, 0x42, 0x25, 0x5a, 0x23, 0x2e, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x3b,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x67, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_params_transfer_proto_rawDescOnce sync.Once
+	file_params_transfer_proto_rawDescData = file_params_transfer_proto_rawDesc
+)
+
+func file_params_transfer_proto_rawDescGZIP() []byte {
+	file_params_transfer_proto_rawDescOnce.Do(func() {
+		file_params_transfer_proto_rawDescData = protoimpl.X.CompressGZIP(file_params_transfer_proto_rawDescData)
+	})
+	return file_params_transfer_proto_rawDescData
+}
+
+var file_params_transfer_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_params_transfer_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_params_transfer_proto_goTypes = []any{
+	(ImportConflictMode)(0),        // 0: ingvarmattis.services.example.v1.ImportConflictMode
+	(ImportAction)(0),              // 1: ingvarmattis.services.example.v1.ImportAction
+	(*ExportServicesRequest)(nil),  // 2: ingvarmattis.services.example.v1.ExportServicesRequest
+	(*ExportServicesResponse)(nil), // 3: ingvarmattis.services.example.v1.ExportServicesResponse
+	(*ImportServicesRequest)(nil),  // 4: ingvarmattis.services.example.v1.ImportServicesRequest
+	(*ImportOptions)(nil),          // 5: ingvarmattis.services.example.v1.ImportOptions
+	(*ImportServicesResponse)(nil), // 6: ingvarmattis.services.example.v1.ImportServicesResponse
+	(*ImportDiff)(nil),             // 7: ingvarmattis.services.example.v1.ImportDiff
+	(*Service)(nil),                // 8: ingvarmattis.services.example.v1.Service
+	(*ServiceHistoryEvent)(nil),    // 9: ingvarmattis.services.example.v1.ServiceHistoryEvent
+}
+var file_params_transfer_proto_depIdxs = []int32{
+	8, // 0: ingvarmattis.services.example.v1.ExportServicesResponse.Service:type_name -> ingvarmattis.services.example.v1.Service
+	9, // 1: ingvarmattis.services.example.v1.ExportServicesResponse.Event:type_name -> ingvarmattis.services.example.v1.ServiceHistoryEvent
+	5, // 2: ingvarmattis.services.example.v1.ImportServicesRequest.Options:type_name -> ingvarmattis.services.example.v1.ImportOptions
+	8, // 3: ingvarmattis.services.example.v1.ImportServicesRequest.Service:type_name -> ingvarmattis.services.example.v1.Service
+	9, // 4: ingvarmattis.services.example.v1.ImportServicesRequest.Event:type_name -> ingvarmattis.services.example.v1.ServiceHistoryEvent
+	0, // 5: ingvarmattis.services.example.v1.ImportOptions.ConflictMode:type_name -> ingvarmattis.services.example.v1.ImportConflictMode
+	7, // 6: ingvarmattis.services.example.v1.ImportServicesResponse.Diff:type_name -> ingvarmattis.services.example.v1.ImportDiff
+	1, // 7: ingvarmattis.services.example.v1.ImportDiff.Action:type_name -> ingvarmattis.services.example.v1.ImportAction
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_params_transfer_proto_init() }
+func file_params_transfer_proto_init() {
+	if File_params_transfer_proto != nil {
+		return
+	}
+	file_params_service_proto_init()
+	file_params_service_history_proto_init()
+	file_params_transfer_proto_msgTypes[1].OneofWrappers = []any{
+		(*ExportServicesResponse_Service)(nil),
+		(*ExportServicesResponse_Event)(nil),
+	}
+	file_params_transfer_proto_msgTypes[2].OneofWrappers = []any{
+		(*ImportServicesRequest_Service)(nil),
+		(*ImportServicesRequest_Event)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_params_transfer_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_params_transfer_proto_goTypes,
+		DependencyIndexes: file_params_transfer_proto_depIdxs,
+		EnumInfos:         file_params_transfer_proto_enumTypes,
+		MessageInfos:      file_params_transfer_proto_msgTypes,
+	}.Build()
+	File_params_transfer_proto = out.File
+	file_params_transfer_proto_rawDesc = nil
+	file_params_transfer_proto_goTypes = nil
+	file_params_transfer_proto_depIdxs = nil
+}
@@ -0,0 +1,337 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.1
+// 	protoc        v5.27.0
+// source: params/watch_services.proto
+
+package servergrpc
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ServiceEventType int32
+
+const (
+	ServiceEventType_SERVICE_EVENT_TYPE_UNSPECIFIED ServiceEventType = 0
+	ServiceEventType_SERVICE_EVENT_TYPE_ADDED       ServiceEventType = 1
+	ServiceEventType_SERVICE_EVENT_TYPE_UPDATED     ServiceEventType = 2
+	ServiceEventType_SERVICE_EVENT_TYPE_REMOVED     ServiceEventType = 3
+	// Also sent when a registered instance changes its endpoints, it replaces the instance with the same id.
+	ServiceEventType_SERVICE_EVENT_TYPE_INSTANCE_ADDED ServiceEventType = 4
+	// The instance has been unregistered or missed its lease.
+	ServiceEventType_SERVICE_EVENT_TYPE_INSTANCE_REMOVED ServiceEventType = 5
+)
+
+// Enum value maps for ServiceEventType.
+var (
+	ServiceEventType_name = map[int32]string{
+		0: "SERVICE_EVENT_TYPE_UNSPECIFIED",
+		1: "SERVICE_EVENT_TYPE_ADDED",
+		2: "SERVICE_EVENT_TYPE_UPDATED",
+		3: "SERVICE_EVENT_TYPE_REMOVED",
+		4: "SERVICE_EVENT_TYPE_INSTANCE_ADDED",
+		5: "SERVICE_EVENT_TYPE_INSTANCE_REMOVED",
+	}
+	ServiceEventType_value = map[string]int32{
+		"SERVICE_EVENT_TYPE_UNSPECIFIED":      0,
+		"SERVICE_EVENT_TYPE_ADDED":            1,
+		"SERVICE_EVENT_TYPE_UPDATED":          2,
+		"SERVICE_EVENT_TYPE_REMOVED":          3,
+		"SERVICE_EVENT_TYPE_INSTANCE_ADDED":   4,
+		"SERVICE_EVENT_TYPE_INSTANCE_REMOVED": 5,
+	}
+)
+
+func (x ServiceEventType) Enum() *ServiceEventType {
+	p := new(ServiceEventType)
+	*p = x
+	return p
+}
+
+func (x ServiceEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ServiceEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_params_watch_services_proto_enumTypes[0].Descriptor()
+}
+
+func (ServiceEventType) Type() protoreflect.EnumType {
+	return &file_params_watch_services_proto_enumTypes[0]
+}
+
+func (x ServiceEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ServiceEventType.Descriptor instead.
+func (ServiceEventType) EnumDescriptor() ([]byte, []int) {
+	return file_params_watch_services_proto_rawDescGZIP(), []int{0}
+}
+
+type WatchServicesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Last revision the client has seen. Events after it are sent first, so a client that reconnects
+	// with the revision of the last event it received does not miss anything. Zero starts from now.
+	FromRevision int64 `protobuf:"varint,1,opt,name=FromRevision,proto3" json:"FromRevision,omitempty"`
+	// Only events of this service are sent when set, such as to a client following a single service.
+	ServiceName   string `protobuf:"bytes,2,opt,name=ServiceName,proto3" json:"ServiceName,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchServicesRequest) Reset() {
+	*x = WatchServicesRequest{}
+	mi := &file_params_watch_services_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchServicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchServicesRequest) ProtoMessage() {}
+
+func (x *WatchServicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_params_watch_services_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchServicesRequest.ProtoReflect.Descriptor instead.
+func (*WatchServicesRequest) Descriptor() ([]byte, []int) {
+	return file_params_watch_services_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WatchServicesRequest) GetFromRevision() int64 {
+	if x != nil {
+		return x.FromRevision
+	}
+	return 0
+}
+
+func (x *WatchServicesRequest) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+type WatchServicesResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Revision int64                  `protobuf:"varint,1,opt,name=Revision,proto3" json:"Revision,omitempty"`
+	Type     ServiceEventType       `protobuf:"varint,2,opt,name=Type,proto3,enum=ingvarmattis.services.example.v1.ServiceEventType" json:"Type,omitempty"`
+	// State after the change, or the last known state for SERVICE_EVENT_TYPE_REMOVED.
+	// The current state of the service for the events of its instances.
+	Service   *Service               `protobuf:"bytes,3,opt,name=Service,proto3" json:"Service,omitempty"`
+	ChangedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=ChangedAt,proto3" json:"ChangedAt,omitempty"`
+	// The instance of SERVICE_EVENT_TYPE_INSTANCE_ADDED and SERVICE_EVENT_TYPE_INSTANCE_REMOVED,
+	// with its last known state for the latter.
+	Instance      *Instance `protobuf:"bytes,5,opt,name=Instance,proto3" json:"Instance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchServicesResponse) Reset() {
+	*x = WatchServicesResponse{}
+	mi := &file_params_watch_services_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchServicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchServicesResponse) ProtoMessage() {}
+
+func (x *WatchServicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_params_watch_services_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchServicesResponse.ProtoReflect.Descriptor instead.
+func (*WatchServicesResponse) Descriptor() ([]byte, []int) {
+	return file_params_watch_services_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WatchServicesResponse) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *WatchServicesResponse) GetType() ServiceEventType {
+	if x != nil {
+		return x.Type
+	}
+	return ServiceEventType_SERVICE_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *WatchServicesResponse) GetService() *Service {
+	if x != nil {
+		return x.Service
+	}
+	return nil
+}
+
+func (x *WatchServicesResponse) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+func (x *WatchServicesResponse) GetInstance() *Instance {
+	if x != nil {
+		return x.Instance
+	}
+	return nil
+}
+
+var File_params_watch_services_proto protoreflect.FileDescriptor
+
+var file_params_watch_services_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2f, 0x77, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x20, 0x69,
+	0x6e, 0x67, 0x76, 0x61, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x69, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x1a,
+	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x16, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x5c,
+	0x0a, 0x14, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x46, 0x72, 0x6f, 0x6d, 0x52, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x46, 0x72,
+	0x6f, 0x6d, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xc2, 0x02, 0x0a,
+	0x15, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x46, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x32, 0x2e, 0x69, 0x6e, 0x67, 0x76, 0x61, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x69, 0x73, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x43, 0x0a, 0x07, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x6e,
+	0x67, 0x76, 0x61, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x69, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x38, 0x0a, 0x09, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x41, 0x74, 0x12, 0x46, 0x0a, 0x08, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x69, 0x6e,
+	0x67, 0x76, 0x61, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x69, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x2a, 0xe4, 0x01, 0x0a, 0x10, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x1e, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43,
+	0x45, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x53, 0x45,
+	0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x45, 0x52, 0x56,
+	0x49, 0x43, 0x45, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55,
+	0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x45, 0x52, 0x56,
+	0x49, 0x43, 0x45, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52,
+	0x45, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x03, 0x12, 0x25, 0x0a, 0x21, 0x53, 0x45, 0x52, 0x56,
+	0x49, 0x43, 0x45, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49,
+	0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x04, 0x12,
+	0x27, 0x0a, 0x23, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x52,
+	0x45, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x05, 0x42, 0x25, 0x5a, 0x23, 0x2e, 0x2f, 0x67, 0x65,
+	0x6e, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x65, 0x78, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x3b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x67, 0x72, 0x70, 0x63, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_params_watch_services_proto_rawDescOnce sync.Once
+	file_params_watch_services_proto_rawDescData = file_params_watch_services_proto_rawDesc
+)
+
+func file_params_watch_services_proto_rawDescGZIP() []byte {
+	file_params_watch_services_proto_rawDescOnce.Do(func() {
+		file_params_watch_services_proto_rawDescData = protoimpl.X.CompressGZIP(file_params_watch_services_proto_rawDescData)
+	})
+	return file_params_watch_services_proto_rawDescData
+}
+
+var file_params_watch_services_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_params_watch_services_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_params_watch_services_proto_goTypes = []any{
+	(ServiceEventType)(0),         // 0: ingvarmattis.services.example.v1.ServiceEventType
+	(*WatchServicesRequest)(nil),  // 1: ingvarmattis.services.example.v1.WatchServicesRequest
+	(*WatchServicesResponse)(nil), // 2: ingvarmattis.services.example.v1.WatchServicesResponse
+	(*Service)(nil),               // 3: ingvarmattis.services.example.v1.Service
+	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+	(*Instance)(nil),              // 5: ingvarmattis.services.example.v1.Instance
+}
+var file_params_watch_services_proto_depIdxs = []int32{
+	0, // 0: ingvarmattis.services.example.v1.WatchServicesResponse.Type:type_name -> ingvarmattis.services.example.v1.ServiceEventType
+	3, // 1: ingvarmattis.services.example.v1.WatchServicesResponse.Service:type_name -> ingvarmattis.services.example.v1.Service
+	4, // 2: ingvarmattis.services.example.v1.WatchServicesResponse.ChangedAt:type_name -> google.protobuf.Timestamp
+	5, // 3: ingvarmattis.services.example.v1.WatchServicesResponse.Instance:type_name -> ingvarmattis.services.example.v1.Instance
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_params_watch_services_proto_init() }
+func file_params_watch_services_proto_init() {
+	if File_params_watch_services_proto != nil {
+		return
+	}
+	file_params_instances_proto_init()
+	file_params_service_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_params_watch_services_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_params_watch_services_proto_goTypes,
+		DependencyIndexes: file_params_watch_services_proto_depIdxs,
+		EnumInfos:         file_params_watch_services_proto_enumTypes,
+		MessageInfos:      file_params_watch_services_proto_msgTypes,
+	}.Build()
+	File_params_watch_services_proto = out.File
+	file_params_watch_services_proto_rawDesc = nil
+	file_params_watch_services_proto_goTypes = nil
+	file_params_watch_services_proto_depIdxs = nil
+}
@@ -0,0 +1,109 @@
+package server
+
+import (
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/ingvarmattis/example/gen/reasons"
+	"github.com/ingvarmattis/example/src/i18n"
+)
+
+// handleCORS applies the CORS policy of the route to the response. It answers preflight requests itself,
+// rejecting those the policy does not allow, and reports whether it did.
+func (s *Server) handleCORS(w http.ResponseWriter, r *http.Request) bool {
+	if s.corsPolicies == nil {
+		return false
+	}
+
+	requestedMethod := r.Header.Get("Access-Control-Request-Method")
+	preflight := r.Method == http.MethodOptions && requestedMethod != ""
+
+	header := w.Header()
+
+	// responses differ by origin, caches must not hand one to another origin
+	header.Add("Vary", "Origin")
+
+	if preflight {
+		header.Add("Vary", "Access-Control-Request-Method")
+		header.Add("Vary", "Access-Control-Request-Headers")
+	}
+
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return false
+	}
+
+	policy := s.corsPolicies.For(r)
+
+	if !preflight {
+		if !policy.AllowsOrigin(origin) {
+			// served without CORS headers, the browser keeps the response from the page
+			return false
+		}
+
+		header.Set("Access-Control-Allow-Origin", policy.AllowOrigin(origin))
+
+		if policy.AllowCredentials {
+			header.Set("Access-Control-Allow-Credentials", "true")
+		}
+
+		if len(policy.ExposedHeaders) > 0 {
+			header.Set("Access-Control-Expose-Headers", strings.Join(policy.ExposedHeaders, ", "))
+		}
+
+		return false
+	}
+
+	requestedHeaders := requestedHeaders(r)
+
+	if err := policy.Preflight(origin, requestedMethod, requestedHeaders); err != nil {
+		ctx := r.Context()
+		err = GRPCError(reasons.CorsRejected, err)
+
+		if s.Bundles != nil {
+			// no call has been made, Accept-Language is not in the metadata Localize reads yet
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(i18n.Header, r.Header.Get("Accept-Language")))
+			err = s.Bundles.Localize(ctx, err)
+		}
+
+		writeProblem(ctx, s.Logger, w, r, err)
+
+		return true
+	}
+
+	header.Set("Access-Control-Allow-Origin", policy.AllowOrigin(origin))
+	header.Set("Access-Control-Allow-Methods", strings.Join(policy.AllowedMethods, ", "))
+
+	if len(requestedHeaders) > 0 {
+		header.Set("Access-Control-Allow-Headers", strings.Join(requestedHeaders, ", "))
+	}
+
+	if policy.AllowCredentials {
+		header.Set("Access-Control-Allow-Credentials", "true")
+	}
+
+	if maxAge := policy.MaxAgeSeconds(); maxAge != "" {
+		header.Set("Access-Control-Max-Age", maxAge)
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+
+	return true
+}
+
+// requestedHeaders are the headers of Access-Control-Request-Headers, a preflight may send them in several lines.
+func requestedHeaders(r *http.Request) []string {
+	var headers []string
+
+	for _, line := range r.Header.Values("Access-Control-Request-Headers") {
+		for header := range strings.SplitSeq(line, ",") {
+			if header = strings.TrimSpace(header); header != "" {
+				headers = append(headers, header)
+			}
+		}
+	}
+
+	return headers
+}
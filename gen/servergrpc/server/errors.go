@@ -0,0 +1,118 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/protoadapt"
+
+	"github.com/ingvarmattis/example/gen/reasons"
+	exampleSvc "github.com/ingvarmattis/example/src/services/example"
+)
+
+// DomainErrors maps the errors of the service layer to the status clients get. GRPCDomainError consults it,
+// so handlers return domain errors as they are and the right code follows them through errors.Is and errors.As.
+var DomainErrors = newDomainErrors()
+
+func newDomainErrors() *ErrorRegistry {
+	registry := &ErrorRegistry{entries: nil}
+
+	RegisterErrorType(registry, reasons.InvalidUpdateMask,
+		func(err *exampleSvc.InvalidUpdateMaskError) []protoadapt.MessageV1 {
+			return []protoadapt.MessageV1{badRequest(err.Violations)}
+		},
+	)
+	registry.Register(exampleSvc.ErrInvalidPageToken, reasons.InvalidPageToken)
+	RegisterErrorType(registry, reasons.InvalidLabelSelector,
+		func(err *exampleSvc.SelectorSyntaxError) []protoadapt.MessageV1 {
+			return []protoadapt.MessageV1{selectorViolation("LabelSelector", err)}
+		},
+	)
+
+	// clients such as the discovery resolver tell a missing service apart from a failing registry
+	registry.Register(exampleSvc.ErrNotFound, reasons.ServiceNotFound)
+	registry.Register(exampleSvc.ErrAlreadyExists, reasons.ServiceAlreadyExists)
+	registry.Register(exampleSvc.ErrInstanceNotFound, reasons.InstanceNotFound)
+	registry.Register(exampleSvc.ErrImportConflict, reasons.ImportConflict)
+
+	registry.Register(exampleSvc.ErrVersionMismatch, reasons.ResourceVersionMismatch)
+	registry.Register(exampleSvc.ErrWatchLagged, reasons.WatchLagged)
+
+	registry.Register(exampleSvc.ErrDependencyCycle, reasons.DependencyCycle)
+	registry.Register(exampleSvc.ErrUnknownDependency, reasons.UnknownDependency)
+	registry.Register(exampleSvc.ErrRevisionCompacted, reasons.RevisionCompacted)
+
+	registry.Register(exampleSvc.ErrWatchClosed, reasons.ChangeStreamClosed)
+	registry.Register(exampleSvc.ErrUnavailable, reasons.StorageUnavailable)
+
+	registry.Register(context.Canceled, reasons.Canceled)
+	registry.Register(context.DeadlineExceeded, reasons.DeadlineExceeded)
+
+	return registry
+}
+
+// selectorViolation points field, the one the selector came in, at the offending token and its position.
+func selectorViolation(field string, err *exampleSvc.SelectorSyntaxError) *errdetails.BadRequest {
+	return badRequest([]exampleSvc.FieldViolation{{Field: field, Description: err.Error()}})
+}
+
+// ErrorRegistry maps errors to reasons of the catalog, the status gets the default code of the reason.
+// The first matching registration wins, so an error wrapping several registered ones gets the reason
+// of the one registered first.
+type ErrorRegistry struct {
+	entries []errorEntry
+}
+
+type errorEntry struct {
+	reason reasons.Reason
+	// match reports whether err is of the entry, along with the details that describe it
+	match func(err error) ([]protoadapt.MessageV1, bool)
+}
+
+// Register maps every error that is target according to errors.Is to reason and its default code.
+func (r *ErrorRegistry) Register(target error, reason reasons.Reason) {
+	r.entries = append(r.entries, errorEntry{
+		reason: reason,
+		match: func(err error) ([]protoadapt.MessageV1, bool) {
+			return nil, errors.Is(err, target)
+		},
+	})
+}
+
+// RegisterErrorType maps every error that errors.As finds an E in. describe may be nil,
+// otherwise it adds details built from the error to the status.
+func RegisterErrorType[E error](
+	r *ErrorRegistry, reason reasons.Reason, describe func(err E) []protoadapt.MessageV1,
+) {
+	r.entries = append(r.entries, errorEntry{
+		reason: reason,
+		match: func(err error) ([]protoadapt.MessageV1, bool) {
+			var typed E
+			if !errors.As(err, &typed) {
+				return nil, false
+			}
+
+			if describe == nil {
+				return nil, true
+			}
+
+			return describe(typed), true
+		},
+	})
+}
+
+// status returns the status of err, or false if nothing is registered for it.
+func (r *ErrorRegistry) status(err error) (error, bool) {
+	if err == nil {
+		return nil, false
+	}
+
+	for _, entry := range r.entries {
+		if details, ok := entry.match(err); ok {
+			return GRPCError(entry.reason, err, details...), true
+		}
+	}
+
+	return nil, false
+}
@@ -0,0 +1,255 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/ingvarmattis/example/gen/reasons"
+	"github.com/ingvarmattis/example/src/interceptors"
+	"github.com/ingvarmattis/example/src/log"
+)
+
+const problemContentType = "application/problem+json"
+
+// problem is an RFC 9457 problem details object, Retryable and FieldViolations are extension members.
+type problem struct {
+	Type            string             `json:"type"`
+	Title           string             `json:"title"`
+	Status          int                `json:"status"`
+	Detail          string             `json:"detail,omitempty"`
+	Instance        string             `json:"instance,omitempty"`
+	Retryable       *bool              `json:"retryable,omitempty"`
+	FieldViolations []problemViolation `json:"fieldViolations,omitempty"`
+
+	// language is the one of Detail when it comes from a LocalizedMessage
+	language string
+}
+
+type problemViolation struct {
+	Field       string `json:"field"`
+	Reason      string `json:"reason,omitempty"`
+	Description string `json:"description"`
+}
+
+// problemErrorHandler renders gateway errors as application/problem+json. The type of a problem is derived
+// from the ErrorInfo reason of the status, so it stays the same for every occurrence of an error.
+func problemErrorHandler(logger *log.Zap) runtime.ErrorHandlerFunc {
+	return func(
+		ctx context.Context, _ *runtime.ServeMux, _ runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error,
+	) {
+		writeProblem(ctx, logger, w, r, err)
+	}
+}
+
+// problemStreamErrorHandler renders an error of a server stream that has not sent a message yet, such as
+// a watch from a compacted revision, as application/problem+json like any other error. Once messages
+// have been sent the response is committed, the error becomes its last chunk as in the default handler.
+func problemStreamErrorHandler(logger *log.Zap) runtime.StreamErrorHandlerFunc {
+	return func(ctx context.Context, err error) *status.Status {
+		if resp, ok := ctx.Value(streamResponseKey{}).(*streamResponse); ok && !resp.started {
+			writeProblem(ctx, logger, resp.ResponseWriter, resp.request, err)
+			resp.problem = true
+		}
+
+		return status.Convert(err)
+	}
+}
+
+type streamResponseKey struct{}
+
+// streamResponse is the response of a streaming route, its context holds it for problemStreamErrorHandler.
+type streamResponse struct {
+	http.ResponseWriter
+
+	request *http.Request
+	// started is set once the gateway has written to the response
+	started bool
+	// problem is set once the response is a problem, the error chunk of the gateway is dropped then
+	problem bool
+}
+
+func (r *streamResponse) WriteHeader(statusCode int) {
+	if r.problem {
+		return
+	}
+
+	r.started = true
+	r.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (r *streamResponse) Write(data []byte) (int, error) {
+	if r.problem {
+		return len(data), nil
+	}
+
+	r.started = true
+
+	return r.ResponseWriter.Write(data)
+}
+
+// Unwrap lets http.ResponseController flush the response and set its deadlines.
+func (r *streamResponse) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
+func writeProblem(ctx context.Context, logger *log.Zap, w http.ResponseWriter, r *http.Request, err error) {
+	httpStatus := 0
+
+	var customStatus *runtime.HTTPStatusError
+	if errors.As(err, &customStatus) {
+		err = customStatus.Err
+		httpStatus = customStatus.HTTPStatus
+	}
+
+	st := status.Convert(err)
+	body := problemFromStatus(st, httpStatus)
+
+	w.Header().Del("Trailer")
+	w.Header().Del("Transfer-Encoding")
+
+	// headers such as Deprecation are worth as much on errors, a stream may have set them already
+	md, _ := runtime.ServerMetadataFromContext(ctx)
+	for key, values := range md.HeaderMD {
+		header, forward := outgoingHeaderMatcher(key)
+		if !forward {
+			continue
+		}
+
+		w.Header().Del(header)
+
+		for _, value := range values {
+			w.Header().Add(header, value)
+		}
+	}
+
+	body.Instance = problemInstance(r, md)
+
+	if body.language != "" {
+		w.Header().Set("Content-Language", body.language)
+	}
+
+	if st.Code() == codes.Unauthenticated {
+		w.Header().Set("WWW-Authenticate", st.Message())
+	}
+
+	w.Header().Set("Content-Type", problemContentType)
+	w.WriteHeader(body.Status)
+
+	if err = json.NewEncoder(w).Encode(body); err != nil {
+		logger.Error("cannot write problem details", zap.Error(err))
+	}
+}
+
+// problemFromStatus describes st, httpStatus overrides the status of the catalog unless zero.
+func problemFromStatus(st *status.Status, httpStatus int) *problem {
+	explicitStatus := httpStatus != 0
+	if !explicitStatus {
+		httpStatus = runtime.HTTPStatusFromCode(st.Code())
+	}
+
+	body := &problem{
+		// about:blank tells the problem is no more than its HTTP status
+		Type:            "about:blank",
+		Title:           http.StatusText(httpStatus),
+		Status:          httpStatus,
+		Detail:          st.Message(),
+		Instance:        "",
+		Retryable:       nil,
+		FieldViolations: nil,
+		language:        "",
+	}
+
+	for _, detail := range st.Details() {
+		switch detail := detail.(type) {
+		case *errdetails.ErrorInfo:
+			if detail.GetReason() == "" {
+				continue
+			}
+
+			reason := reasons.Reason(detail.GetReason())
+
+			body.Type = problemType(detail.GetDomain(), reason)
+			body.Title = problemTitle(reason)
+
+			if !reason.Known() {
+				continue
+			}
+
+			retryable := reason.Retryable()
+			body.Retryable = &retryable
+
+			// the catalog may give a reason a status of its own, unless the reason came with another code
+			if !explicitStatus && reason.Code() == st.Code() {
+				body.Status = reason.HTTPStatus()
+			}
+		case *errdetails.LocalizedMessage:
+			// the message of the status is for developers, the localized one for whoever reads the response
+			body.Detail = detail.GetMessage()
+			body.language = detail.GetLocale()
+		case *errdetails.BadRequest:
+			for _, violation := range detail.GetFieldViolations() {
+				body.FieldViolations = append(body.FieldViolations, problemViolation{
+					Field:       violation.GetField(),
+					Reason:      violation.GetReason(),
+					Description: violation.GetDescription(),
+				})
+			}
+		}
+	}
+
+	return body
+}
+
+// problemType turns a reason such as SERVICE_NOT_FOUND into https://<domain>/problems/service-not-found.
+func problemType(errorDomain string, reason reasons.Reason) string {
+	if errorDomain == "" {
+		errorDomain = domain
+	}
+
+	slug := strings.Map(func(r rune) rune {
+		if r == ' ' || r == '_' {
+			return '-'
+		}
+
+		return r
+	}, strings.ToLower(strings.TrimSpace(string(reason))))
+
+	return "https://" + errorDomain + "/problems/" + slug
+}
+
+// problemTitle is the title of the reason in the catalog. Reasons outside of it, such as those of
+// other domains, are turned into a title, SERVICE_NOT_FOUND into "Service not found".
+func problemTitle(reason reasons.Reason) string {
+	if reason.Known() {
+		return reason.Title()
+	}
+
+	title := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(string(reason)), "_", " "))
+	if title == "" {
+		return title
+	}
+
+	return strings.ToUpper(title[:1]) + title[1:]
+}
+
+// problemInstance names the occurrence of a problem by its trace, so that it can be looked up.
+// Without a trace it falls back to the path of the request.
+func problemInstance(r *http.Request, md runtime.ServerMetadata) string {
+	for _, md := range []metadata.MD{md.HeaderMD, md.TrailerMD} {
+		if traceID := md.Get(interceptors.TraceIDHeader); len(traceID) > 0 && traceID[0] != "" {
+			return r.URL.Path + "#trace-" + traceID[0]
+		}
+	}
+
+	return r.URL.Path
+}
@@ -2,10 +2,13 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"net"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	grpcMiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
@@ -14,28 +17,76 @@ import (
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/health"
 	healthGRPC "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/reflection"
+	reflectionGRPC "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	reflectionV1AlphaGRPC "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
 	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/protoadapt"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/ingvarmattis/example/gen/reasons"
 	exampleGRPC "github.com/ingvarmattis/example/gen/servergrpc/example"
+	exampleV2GRPC "github.com/ingvarmattis/example/gen/servergrpc/examplev2"
+	"github.com/ingvarmattis/example/src/actor"
+	"github.com/ingvarmattis/example/src/certs"
+	"github.com/ingvarmattis/example/src/cors"
+	"github.com/ingvarmattis/example/src/deprecation"
+	"github.com/ingvarmattis/example/src/etag"
+	"github.com/ingvarmattis/example/src/i18n"
+	"github.com/ingvarmattis/example/src/identity"
 	"github.com/ingvarmattis/example/src/log"
+	exampleSvc "github.com/ingvarmattis/example/src/services/example"
+	"github.com/ingvarmattis/example/src/webrpc"
 )
 
 const domain = "mattis.dev"
 
-var ErrPortNotSpecified = errors.New("port not specified")
+var (
+	ErrPortNotSpecified   = errors.New("port not specified")
+	ErrUnknownGatewayMode = errors.New("unknown gateway mode")
+	// ErrResourceVersionRequired rejects an update or a deletion that names no resource version to check.
+	ErrResourceVersionRequired = errors.New("resource version required")
+)
 
 type GRPCExampleHandlers interface {
 	ServiceName(ctx context.Context, in *emptypb.Empty) (*exampleGRPC.ServiceNameResponse, error)
 	Status(ctx context.Context, in *exampleGRPC.StatusRequest) (*exampleGRPC.StatusResponse, error)
-}
-
-type GRPCErrors interface {
-	Error() string
+	RegisterService(
+		ctx context.Context, in *exampleGRPC.RegisterServiceRequest,
+	) (*exampleGRPC.RegisterServiceResponse, error)
+	GetService(ctx context.Context, in *exampleGRPC.GetServiceRequest) (*exampleGRPC.GetServiceResponse, error)
+	ListServices(ctx context.Context, in *exampleGRPC.ListServicesRequest) (*exampleGRPC.ListServicesResponse, error)
+	WatchServices(in *exampleGRPC.WatchServicesRequest, stream exampleGRPC.ExampleService_WatchServicesServer) error
+	UpdateService(ctx context.Context, in *exampleGRPC.UpdateServiceRequest) (*exampleGRPC.UpdateServiceResponse, error)
+	Heartbeat(ctx context.Context, in *exampleGRPC.HeartbeatRequest) (*exampleGRPC.HeartbeatResponse, error)
+	RegisterInstance(
+		ctx context.Context, in *exampleGRPC.RegisterInstanceRequest,
+	) (*exampleGRPC.RegisterInstanceResponse, error)
+	ListInstances(ctx context.Context, in *exampleGRPC.ListInstancesRequest) (*exampleGRPC.ListInstancesResponse, error)
+	InstanceHeartbeat(
+		ctx context.Context, in *exampleGRPC.InstanceHeartbeatRequest,
+	) (*exampleGRPC.InstanceHeartbeatResponse, error)
+	UnregisterInstance(ctx context.Context, in *exampleGRPC.UnregisterInstanceRequest) (*emptypb.Empty, error)
+	PatchService(ctx context.Context, in *exampleGRPC.PatchServiceRequest) (*exampleGRPC.PatchServiceResponse, error)
+	ExportServices(in *exampleGRPC.ExportServicesRequest, stream exampleGRPC.ExampleService_ExportServicesServer) error
+	ImportServices(stream exampleGRPC.ExampleService_ImportServicesServer) error
+	UnregisterService(ctx context.Context, in *exampleGRPC.UnregisterServiceRequest) (*emptypb.Empty, error)
+	GetServiceHistory(
+		ctx context.Context, in *exampleGRPC.GetServiceHistoryRequest,
+	) (*exampleGRPC.GetServiceHistoryResponse, error)
+	GetServiceDependencies(
+		ctx context.Context, in *exampleGRPC.GetServiceDependenciesRequest,
+	) (*exampleGRPC.GetServiceDependenciesResponse, error)
+	GetDependencyGraph(
+		ctx context.Context, in *exampleGRPC.GetDependencyGraphRequest,
+	) (*exampleGRPC.GetDependencyGraphResponse, error)
 }
 
 type Server struct {
@@ -44,10 +95,19 @@ type Server struct {
 	GRPCExampleHandlers GRPCExampleHandlers
 
 	Validator *validator.Validate
+	Bundles   *i18n.Bundles
 	Logger    *log.Zap
 
 	grpcServer *grpc.Server
-	httpServer *runtime.ServeMux
+	gatewayMux *runtime.ServeMux
+	// webRPC serves gRPC-Web and Connect calls on the HTTP listener with grpcServer
+	webRPC *webrpc.Handler
+	// corsPolicies decide which origins browsers let read the responses of the gateway, nil for none
+	corsPolicies *cors.Policies
+	// inProcess is the in-memory listener of grpcServer the gateway calls, nil with GatewayLoopback
+	inProcess *bufconn.Listener
+	// httpServer serves the gateway, it is shut down along with grpcServer
+	httpServer *http.Server
 }
 
 func (s *Server) Serve(serviceName string, port *int) error {
@@ -61,6 +121,7 @@ func (s *Server) Serve(serviceName string, port *int) error {
 	}
 
 	s.serveHealthCheck(serviceName)
+	s.serveInProcess()
 
 	s.Logger.Info("starting grpc server", zap.Int("port", *port))
 
@@ -76,49 +137,140 @@ func (s *Server) ServeHTTP(port *int) error {
 		return ErrPortNotSpecified
 	}
 
-	s.Logger.Info("starting http server", zap.Int("port", *port))
-
-	if err := http.ListenAndServe(fmt.Sprintf("0.0.0.0:%d", *port),
-		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			// CORS preflight
-			if r.Method == "OPTIONS" {
-				w.Header().Set("Access-Control-Allow-Origin", "*")
-				w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-				w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
-				w.Header().Set("Access-Control-Max-Age", "3600")
-				w.WriteHeader(http.StatusNoContent)
-				return
-			}
-
-			w.Header().Set("Access-Control-Allow-Origin", "*")
-			w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-			w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
-
-			if r.ProtoMajor == 2 && r.Header.Get("Content-Type") == "application/grpc" {
-				s.grpcServer.ServeHTTP(w, r)
-				return
-			}
-
-			if len(r.URL.Path) > 1 && r.URL.Path[len(r.URL.Path)-1] == '/' {
-				http.Redirect(w, r, r.URL.Path[:len(r.URL.Path)-1], http.StatusPermanentRedirect)
-				return
-			}
-
-			s.httpServer.ServeHTTP(w, r)
-		})); err != nil {
+	l, err := net.Listen("tcp", fmt.Sprintf("0.0.0.0:%d", *port))
+	if err != nil {
+		return err
+	}
+
+	s.Logger.Info("starting http server", zap.Int("port", *port), zap.Bool("tls", s.httpServer.TLSConfig != nil))
+
+	if s.httpServer.TLSConfig != nil {
+		// the certificate comes from TLSConfig, it is reloaded when rotated
+		err = s.httpServer.ServeTLS(l, "", "")
+	} else {
+		err = s.httpServer.Serve(l)
+	}
+
+	if err != nil {
 		return fmt.Errorf("error while serve http | %w", err)
 	}
 
 	return nil
 }
 
+func (s *Server) handleHTTP(w http.ResponseWriter, r *http.Request) {
+	if s.handleCORS(w, r) {
+		return
+	}
+
+	if s.webRPC.Match(r) {
+		if streamingProcedures[r.URL.Path] {
+			liftDeadlines(w)
+		}
+
+		s.webRPC.ServeHTTP(w, r)
+
+		return
+	}
+
+	if r.ProtoMajor == 2 && isGRPC(r.Header.Get("Content-Type")) {
+		if streamingProcedures[r.URL.Path] {
+			liftDeadlines(w)
+		}
+
+		s.grpcServer.ServeHTTP(w, r)
+
+		return
+	}
+
+	if len(r.URL.Path) > 1 && r.URL.Path[len(r.URL.Path)-1] == '/' {
+		http.Redirect(w, r, r.URL.Path[:len(r.URL.Path)-1], http.StatusPermanentRedirect)
+		return
+	}
+
+	s.gatewayMux.ServeHTTP(w, r)
+}
+
+// isGRPC reports whether contentType is the one of native gRPC, application/grpc with an optional codec.
+func isGRPC(contentType string) bool {
+	return contentType == "application/grpc" ||
+		strings.HasPrefix(contentType, "application/grpc+") || strings.HasPrefix(contentType, "application/grpc;")
+}
+
+// streamingRoutes are the gateway routes of streaming methods. A watch lasts as long as its client wants,
+// an export or an import as long as its data takes, so the read and write timeouts do not apply to them.
+var streamingRoutes = map[string]bool{
+	"/v1/services:watch":  true,
+	"/v1/services:export": true,
+	"/v1/services:import": true,
+}
+
+// streamingProcedures are the paths of the streaming methods served by grpcServer, such as
+// /ingvarmattis.services.example.v1.ExampleService/WatchServices. Like streamingRoutes, the read and write
+// timeouts do not apply to them when they are called over the HTTP listener.
+var streamingProcedures = streamingMethods(
+	&exampleGRPC.ExampleService_ServiceDesc,
+	&exampleV2GRPC.ExampleService_ServiceDesc,
+	&healthGRPC.Health_ServiceDesc,
+	&reflectionGRPC.ServerReflection_ServiceDesc,
+	&reflectionV1AlphaGRPC.ServerReflection_ServiceDesc,
+)
+
+func streamingMethods(descs ...*grpc.ServiceDesc) map[string]bool {
+	methods := make(map[string]bool)
+
+	for _, desc := range descs {
+		for _, stream := range desc.Streams {
+			methods["/"+desc.ServiceName+"/"+stream.StreamName] = true
+		}
+	}
+
+	return methods
+}
+
+func streamingMiddleware(next runtime.HandlerFunc) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		if pattern, ok := runtime.HTTPPattern(r.Context()); ok && streamingRoutes[pattern.String()] {
+			liftDeadlines(w)
+
+			resp := &streamResponse{ResponseWriter: w, request: r, started: false, problem: false}
+			w, r = resp, r.WithContext(context.WithValue(r.Context(), streamResponseKey{}, resp))
+		}
+
+		next(w, r, pathParams)
+	}
+}
+
+// liftDeadlines clears the deadlines the timeouts of the http server set for the request.
+func liftDeadlines(w http.ResponseWriter) {
+	controller := http.NewResponseController(w)
+
+	_ = controller.SetReadDeadline(time.Time{})
+	_ = controller.SetWriteDeadline(time.Time{})
+}
+
 func (s *Server) serveHealthCheck(serviceName string) {
 	healthCheckServer := health.NewServer()
 	healthGRPC.RegisterHealthServer(s.grpcServer, healthCheckServer)
 	healthCheckServer.SetServingStatus(serviceName, healthGRPC.HealthCheckResponse_SERVING)
 }
 
+// serveInProcess serves the calls of the gateway, it stops along with the gRPC listener.
+func (s *Server) serveInProcess() {
+	if s.inProcess == nil {
+		return
+	}
+
+	go func() {
+		if err := s.grpcServer.Serve(s.inProcess); err != nil {
+			s.Logger.Error("error while serve in-process grpc", zap.Error(err))
+		}
+	}()
+}
+
 func (s *Server) ServeWithCustomListener(l net.Listener) error {
+	s.serveInProcess()
+
 	s.Logger.Info("starting grpc server with custom listener", zap.Int("port", l.Addr().(*net.TCPAddr).Port))
 
 	if err := s.grpcServer.Serve(l); err != nil {
@@ -128,28 +280,118 @@ func (s *Server) ServeWithCustomListener(l net.Listener) error {
 	return nil
 }
 
-// Close stops the gRPC server gracefully. It stops the server from
-// accepting new connections and RPCs and blocks until all the pending RPCs are
-// finished.
-func (s *Server) Close() {
-	s.grpcServer.GracefulStop()
+// Shutdown stops both servers gracefully. The gateway goes first, it stops accepting connections and waits
+// for the running requests, which are calls of the gRPC server. Then the gRPC server stops accepting
+// connections and RPCs and waits for the pending ones. Whatever still runs when ctx is done is cut off.
+func (s *Server) Shutdown(ctx context.Context) error {
+	httpErr := s.httpServer.Shutdown(ctx)
+	if httpErr != nil {
+		_ = s.httpServer.Close()
+	}
+
+	stopped := make(chan struct{})
+
+	go func() {
+		s.grpcServer.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		s.grpcServer.Stop()
+		<-stopped
+	}
+
+	if httpErr != nil {
+		return fmt.Errorf("error while shutdown http | %w", httpErr)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("error while shutdown grpc | %w", err)
+	}
+
+	return nil
 }
 
 type NewServerOptions struct {
 	ServiceName string
 
-	GRPCExampleHandlers GRPCExampleHandlers
+	GRPCExampleHandlers   GRPCExampleHandlers
+	GRPCExampleV2Handlers GRPCExampleV2Handlers
 
 	Logger    *log.Zap
 	Validator *validator.Validate
+	// Bundles describe validation failures in the language of the caller,
+	// without them they keep the messages of the validator.
+	Bundles *i18n.Bundles
 
 	UnaryInterceptors  []grpc.UnaryServerInterceptor
 	StreamInterceptors []grpc.StreamServerInterceptor
 
 	ServerOptions []grpc.ServerOption
+
+	HTTPTimeouts HTTPTimeouts
+	// GatewayMode is how the gateway reaches the gRPC server, GatewayInProcess when empty.
+	GatewayMode GatewayMode
+	// CORS are the policies of cross-origin requests to the gateway, without them browsers only
+	// let pages of the same origin read its responses.
+	CORS *cors.Policies
+	// TLS secures both listeners, they are plaintext without it. With mutual TLS the gateway forwards
+	// the certificates of its clients to gRPC.
+	TLS *certs.Reloader
 }
 
-func NewServer(ctx context.Context, grpcPort int, opts *NewServerOptions) *Server {
+// HTTPTimeouts bound the requests of the gateway, zero means no limit.
+// Streaming methods are exempt from Read and Write.
+type HTTPTimeouts struct {
+	ReadHeader time.Duration
+	Read       time.Duration
+	Write      time.Duration
+	Idle       time.Duration
+}
+
+// GatewayMode is how the gateway calls the gRPC server. Either way the calls pass the interceptors of the server.
+type GatewayMode string
+
+const (
+	// GatewayInProcess calls the gRPC server through an in-memory listener, without a network hop.
+	GatewayInProcess GatewayMode = "inprocess"
+	// GatewayLoopback dials the gRPC port of the same process over TCP.
+	GatewayLoopback GatewayMode = "loopback"
+)
+
+// ParseGatewayMode returns the mode named mode, GatewayInProcess when it is empty.
+func ParseGatewayMode(mode string) (GatewayMode, error) {
+	switch GatewayMode(mode) {
+	case GatewayInProcess, "":
+		return GatewayInProcess, nil
+	case GatewayLoopback:
+		return GatewayLoopback, nil
+	default:
+		return "", fmt.Errorf("%w %q, expected %s or %s", ErrUnknownGatewayMode, mode, GatewayInProcess, GatewayLoopback)
+	}
+}
+
+// inProcessBufferSize is the size of the in-memory connection buffers of GatewayInProcess.
+const inProcessBufferSize = 1 << 20
+
+// NewServer builds the gRPC server and the gateway in front of it, it fails with ErrUnknownGatewayMode
+// when opts.GatewayMode is none of the modes.
+func NewServer(ctx context.Context, grpcPort int, opts *NewServerOptions) (*Server, error) {
+	var inProcess *bufconn.Listener
+
+	target := fmt.Sprintf("0.0.0.0:%v", grpcPort)
+
+	switch opts.GatewayMode {
+	case GatewayInProcess, "":
+		inProcess = bufconn.Listen(inProcessBufferSize)
+		target = "passthrough:///inprocess"
+	case GatewayLoopback:
+	default:
+		return nil, fmt.Errorf("%w %q", ErrUnknownGatewayMode, opts.GatewayMode)
+	}
+
 	srvOpts := make([]grpc.ServerOption, 0)
 
 	srvOpts = append(
@@ -158,9 +400,26 @@ func NewServer(ctx context.Context, grpcPort int, opts *NewServerOptions) *Serve
 		grpc.StreamInterceptor(grpcMiddleware.ChainStreamServer(opts.StreamInterceptors...)),
 	)
 
+	httpOpts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+
+	var httpTLS *tls.Config
+
+	if opts.TLS != nil {
+		srvOpts = append(srvOpts, grpc.Creds(credentials.NewTLS(opts.TLS.ServerConfig("h2"))))
+		httpOpts = []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(opts.TLS.LoopbackConfig()))}
+		httpTLS = opts.TLS.ServerConfig("h2", "http/1.1")
+	}
+
 	grpcServer := grpc.NewServer(srvOpts...)
 
-	httpServer := runtime.NewServeMux()
+	gatewayMux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher),
+		runtime.WithErrorHandler(problemErrorHandler(opts.Logger)),
+		runtime.WithStreamErrorHandler(problemStreamErrorHandler(opts.Logger)),
+		runtime.WithMiddlewares(streamingMiddleware),
+		runtime.WithMetadata(forwardClientCertificate),
+	)
 
 	if opts.Validator == nil {
 		opts.Validator = validator.New()
@@ -172,30 +431,100 @@ func NewServer(ctx context.Context, grpcPort int, opts *NewServerOptions) *Serve
 		GRPCExampleHandlers: opts.GRPCExampleHandlers,
 
 		Validator: opts.Validator,
+		Bundles:   opts.Bundles,
 		Logger:    opts.Logger,
 
 		grpcServer: grpcServer,
-		httpServer: httpServer,
+		gatewayMux: gatewayMux,
+		webRPC:     webrpc.NewHandler(grpcServer),
+		inProcess:  inProcess,
+		httpServer: nil,
+
+		corsPolicies: opts.CORS,
+	}
+	s.httpServer = &http.Server{
+		Handler:           http.HandlerFunc(s.handleHTTP),
+		ReadHeaderTimeout: opts.HTTPTimeouts.ReadHeader,
+		ReadTimeout:       opts.HTTPTimeouts.Read,
+		WriteTimeout:      opts.HTTPTimeouts.Write,
+		IdleTimeout:       opts.HTTPTimeouts.Idle,
+		TLSConfig:         httpTLS,
 	}
 	exampleGRPC.RegisterExampleServiceServer(grpcServer, &s)
 
-	httpOpts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	// both versions share the listeners, the gateway routes them by the /v1 and /v2 prefixes
+	exampleV2GRPC.RegisterExampleServiceServer(grpcServer, &ServerV2{
+		UnimplementedExampleServiceServer: exampleV2GRPC.UnimplementedExampleServiceServer{},
+
+		GRPCExampleV2Handlers: opts.GRPCExampleV2Handlers,
 
-	if err := exampleGRPC.RegisterExampleServiceHandlerFromEndpoint(
-		ctx, httpServer, fmt.Sprintf("0.0.0.0:%v", grpcPort), httpOpts,
-	); err != nil {
-		panic(err)
+		Validator: opts.Validator,
+		Bundles:   opts.Bundles,
+	})
+
+	if inProcess != nil {
+		httpOpts = append(httpOpts, grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return inProcess.DialContext(ctx)
+		}))
+	}
+
+	if err := exampleGRPC.RegisterExampleServiceHandlerFromEndpoint(ctx, gatewayMux, target, httpOpts); err != nil {
+		return nil, fmt.Errorf("cannot register gateway handlers | %w", err)
+	}
+
+	if err := exampleV2GRPC.RegisterExampleServiceHandlerFromEndpoint(ctx, gatewayMux, target, httpOpts); err != nil {
+		return nil, fmt.Errorf("cannot register gateway v2 handlers | %w", err)
 	}
 
 	reflection.Register(grpcServer)
 
-	return &s
+	return &s, nil
+}
+
+// incomingHeaderMatcher forwards the actor and If-Match headers to gRPC in addition to the default ones.
+// The forwarded client certificate is set by the gateway alone, clients cannot pass one of their own.
+func incomingHeaderMatcher(key string) (string, bool) {
+	switch {
+	case strings.EqualFold(key, runtime.MetadataHeaderPrefix+identity.ForwardedCertHeader):
+		return "", false
+	case strings.EqualFold(key, actor.Header):
+		return actor.Header, true
+	case strings.EqualFold(key, etag.IfMatchHeader):
+		return etag.IfMatchHeader, true
+	}
+
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// forwardClientCertificate hands the verified certificate of an HTTP client over to gRPC,
+// so that handlers see the identity of the client rather than the one of the gateway.
+func forwardClientCertificate(_ context.Context, r *http.Request) metadata.MD {
+	if r.TLS == nil || len(r.TLS.PeerCertificates) == 0 {
+		return nil
+	}
+
+	return metadata.Pairs(identity.ForwardedCertHeader, string(r.TLS.PeerCertificates[0].Raw))
+}
+
+// outgoingHeaderMatcher returns the entity tag and the deprecation of a method as plain HTTP headers,
+// other metadata keeps the gateway prefix.
+func outgoingHeaderMatcher(key string) (string, bool) {
+	switch key {
+	case etag.Header:
+		return "ETag", true
+	case deprecation.Header:
+		return "Deprecation", true
+	case deprecation.LinkHeader:
+		return "Link", true
+	}
+
+	return runtime.MetadataHeaderPrefix + key, true
 }
 
 func (s *Server) ServiceName(ctx context.Context, req *emptypb.Empty) (*exampleGRPC.ServiceNameResponse, error) {
 	resp, err := s.GRPCExampleHandlers.ServiceName(ctx, req)
 	if err != nil {
-		return nil, GRPCUnknownError(err, nil)
+		return nil, GRPCDomainError(err)
 	}
 
 	return resp, nil
@@ -210,49 +539,757 @@ func (s *Server) Status(ctx context.Context, req *exampleGRPC.StatusRequest) (*e
 		ServiceName: req.GetServiceName(),
 	}
 
-	if err := validate(s.Validator, reqT, errors.New("status error")); err != nil {
+	if err := validate(ctx, s.Validator, s.Bundles, reqT); err != nil {
 		return nil, err
 	}
 
 	resp, err := s.GRPCExampleHandlers.Status(ctx, req)
 	if err != nil {
-		return nil, GRPCUnknownError(err, nil)
+		return nil, GRPCDomainError(err)
+	}
+
+	return resp, nil
+}
+
+type endpointT struct {
+	Protocol exampleGRPC.EndpointProtocol `validate:"required,protoEnum"`
+	Address  string                       `validate:"required,hostname_port"`
+}
+
+type probeT struct {
+	Protocol exampleGRPC.EndpointProtocol `validate:"required,protoEnum"`
+	// host:port for grpc, a url or host:port/path for http
+	Address string `validate:"required,max=2048"`
+}
+
+type registerServiceT struct {
+	ServiceName string            `validate:"required,serviceName"`
+	Description string            `validate:"max=1024"`
+	OwnerTeam   string            `validate:"max=128"`
+	Version     string            `validate:"omitempty,semver"`
+	Endpoints   []endpointT       `validate:"max=64,dive"`
+	Labels      map[string]string `validate:"max=64,dive,keys,labelKey,endkeys,max=256"`
+	TTL         time.Duration     `validate:"omitempty,min=1s,max=24h" proto:"Ttl"`
+	Probe       *probeT           `validate:"omitempty"`
+	DependsOn   []string          `validate:"max=64,unique,dive,serviceName"`
+}
+
+func (s *Server) RegisterService(
+	ctx context.Context, req *exampleGRPC.RegisterServiceRequest,
+) (*exampleGRPC.RegisterServiceResponse, error) {
+	reqT := registerServiceT{
+		ServiceName: req.GetServiceName(),
+		Description: req.GetDescription(),
+		OwnerTeam:   req.GetOwnerTeam(),
+		Version:     req.GetVersion(),
+		Endpoints:   endpointsT(req.GetEndpoints()),
+		Labels:      req.GetLabels(),
+		TTL:         req.GetTtl().AsDuration(),
+		Probe:       probeTFromProto(req.GetProbe()),
+		DependsOn:   req.GetDependsOn(),
+	}
+
+	if err := validate(ctx, s.Validator, s.Bundles, reqT); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.GRPCExampleHandlers.RegisterService(ctx, req)
+	if err != nil {
+		return nil, GRPCDomainError(err)
+	}
+
+	return resp, nil
+}
+
+type getServiceT struct {
+	ServiceName string `validate:"required,serviceName"`
+}
+
+func (s *Server) GetService(
+	ctx context.Context, req *exampleGRPC.GetServiceRequest,
+) (*exampleGRPC.GetServiceResponse, error) {
+	reqT := getServiceT{
+		ServiceName: req.GetServiceName(),
+	}
+
+	if err := validate(ctx, s.Validator, s.Bundles, reqT); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.GRPCExampleHandlers.GetService(ctx, req)
+	if err != nil {
+		return nil, GRPCDomainError(err)
+	}
+
+	return resp, nil
+}
+
+type listServicesT struct {
+	PageSize      int32                 `validate:"gte=0,lte=1000"`
+	SortField     exampleGRPC.SortField `validate:"protoEnum"`
+	SortOrder     exampleGRPC.SortOrder `validate:"protoEnum"`
+	LabelSelector string                `validate:"max=4096"`
+}
+
+func (s *Server) ListServices(
+	ctx context.Context, req *exampleGRPC.ListServicesRequest,
+) (*exampleGRPC.ListServicesResponse, error) {
+	reqT := listServicesT{
+		PageSize:      req.GetPageSize(),
+		SortField:     req.GetSortField(),
+		SortOrder:     req.GetSortOrder(),
+		LabelSelector: req.GetLabelSelector(),
+	}
+
+	if err := validate(ctx, s.Validator, s.Bundles, reqT); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.GRPCExampleHandlers.ListServices(ctx, req)
+	if err != nil {
+		return nil, GRPCDomainError(err)
+	}
+
+	return resp, nil
+}
+
+type watchServicesT struct {
+	FromRevision int64  `validate:"gte=0"`
+	ServiceName  string `validate:"omitempty,serviceName"`
+}
+
+func (s *Server) WatchServices(
+	req *exampleGRPC.WatchServicesRequest, stream exampleGRPC.ExampleService_WatchServicesServer,
+) error {
+	reqT := watchServicesT{
+		FromRevision: req.GetFromRevision(),
+		ServiceName:  req.GetServiceName(),
+	}
+
+	if err := validate(stream.Context(), s.Validator, s.Bundles, reqT); err != nil {
+		return err
+	}
+
+	if err := s.GRPCExampleHandlers.WatchServices(req, stream); err != nil {
+		return GRPCDomainError(err)
+	}
+
+	return nil
+}
+
+func (s *Server) ExportServices(
+	req *exampleGRPC.ExportServicesRequest, stream exampleGRPC.ExampleService_ExportServicesServer,
+) error {
+	if err := s.GRPCExampleHandlers.ExportServices(req, stream); err != nil {
+		return GRPCDomainError(err)
+	}
+
+	return nil
+}
+
+// maxImportRecords bounds an import, the records are held in memory until the stream ends.
+const maxImportRecords = 100_000
+
+var errInvalidImportRecord = errors.New("invalid import record")
+
+type importOptionsT struct {
+	ConflictMode exampleGRPC.ImportConflictMode `validate:"protoEnum"`
+}
+
+type importEventT struct {
+	ServiceName string                              `validate:"required,serviceName"`
+	Type        exampleGRPC.ServiceHistoryEventType `validate:"required,protoEnum"`
+	Actor       string                              `validate:"max=256"`
+}
+
+// importRecordError ends an import at an invalid record, status is what the client gets.
+type importRecordError struct {
+	status error
+}
+
+func (e *importRecordError) Error() string {
+	return e.status.Error()
+}
+
+// importServicesStream validates every record as it is received. Violations name a record by its index
+// in the stream, counted from zero, such as records[2].Service.Endpoints[0].Address.
+type importServicesStream struct {
+	exampleGRPC.ExampleService_ImportServicesServer
+
+	validator *validator.Validate
+	bundles   *i18n.Bundles
+	received  int
+	// services are the names of the services received so far, a name may be imported once
+	services map[string]bool
+}
+
+func (s *importServicesStream) Recv() (*exampleGRPC.ImportServicesRequest, error) {
+	req, err := s.ExampleService_ImportServicesServer.Recv()
+	if err != nil {
+		return nil, err
+	}
+
+	s.received++
+	if s.received > maxImportRecords {
+		return nil, s.rejected(fmt.Errorf("%w | more than %d records", errInvalidImportRecord, maxImportRecords))
+	}
+
+	path := fmt.Sprintf("records[%d]", s.received-1)
+
+	if options := req.GetOptions(); options != nil {
+		if err = s.validate(importOptionsT{ConflictMode: options.GetConflictMode()}, path+".Options"); err != nil {
+			return nil, err
+		}
+	}
+
+	var (
+		reqT  any
+		field string
+	)
+
+	switch record := req.GetRecord().(type) {
+	case *exampleGRPC.ImportServicesRequest_Service:
+		service := record.Service
+
+		if s.services[service.GetServiceName()] {
+			return nil, s.rejected(fmt.Errorf(
+				"%w %d | service %s is imported more than once", errInvalidImportRecord, s.received, service.GetServiceName(),
+			))
+		}
+
+		s.services[service.GetServiceName()] = true
+
+		reqT = registerServiceT{
+			ServiceName: service.GetServiceName(),
+			Description: service.GetDescription(),
+			OwnerTeam:   service.GetOwnerTeam(),
+			Version:     service.GetVersion(),
+			Endpoints:   endpointsT(service.GetEndpoints()),
+			Labels:      service.GetLabels(),
+			TTL:         service.GetTtl().AsDuration(),
+			Probe:       probeTFromProto(service.GetProbe()),
+			DependsOn:   service.GetDependsOn(),
+		}
+		field = path + ".Service"
+	case *exampleGRPC.ImportServicesRequest_Event:
+		reqT = importEventT{
+			ServiceName: record.Event.GetServiceName(),
+			Type:        record.Event.GetType(),
+			Actor:       record.Event.GetActor(),
+		}
+		field = path + ".Event"
+	default:
+		return req, nil
+	}
+
+	if err = s.validate(reqT, field); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// validate checks a part of the current record, field is its path.
+func (s *importServicesStream) validate(reqT any, field string) error {
+	err := validateAt(s.Context(), s.validator, s.bundles, reqT, reasons.InvalidImportRecord, field)
+	if err != nil {
+		return &importRecordError{status: err}
+	}
+
+	return nil
+}
+
+// rejected ends the import at the current record for a reason other than its fields.
+func (s *importServicesStream) rejected(err error) error {
+	return &importRecordError{status: GRPCError(reasons.InvalidImportRecord, err)}
+}
+
+func (s *Server) ImportServices(stream exampleGRPC.ExampleService_ImportServicesServer) error {
+	if err := s.GRPCExampleHandlers.ImportServices(&importServicesStream{
+		ExampleService_ImportServicesServer: stream,
+		validator:                           s.Validator,
+		bundles:                             s.Bundles,
+		received:                            0,
+		services:                            make(map[string]bool),
+	}); err != nil {
+		var recordErr *importRecordError
+		if errors.As(err, &recordErr) {
+			return recordErr.status
+		}
+
+		return GRPCDomainError(err)
+	}
+
+	return nil
+}
+
+type updateServiceT struct {
+	ServiceName    string            `validate:"required,serviceName"`
+	NewServiceName string            `validate:"omitempty,serviceName"`
+	Description    string            `validate:"max=1024"`
+	OwnerTeam      string            `validate:"max=128"`
+	Version        string            `validate:"omitempty,semver"`
+	Endpoints      []endpointT       `validate:"max=64,dive"`
+	Labels         map[string]string `validate:"max=64,dive,keys,labelKey,endkeys,max=256"`
+	Probe          *probeT           `validate:"omitempty"`
+	DependsOn      []string          `validate:"max=64,unique,dive,serviceName"`
+	// ResourceVersion may come from If-Match, see expectedResourceVersion.
+	ResourceVersion int64 `validate:"gte=0"`
+}
+
+func (s *Server) UpdateService(
+	ctx context.Context, req *exampleGRPC.UpdateServiceRequest,
+) (*exampleGRPC.UpdateServiceResponse, error) {
+	reqT := updateServiceT{
+		ServiceName:    req.GetServiceName(),
+		NewServiceName: req.GetNewServiceName(),
+		Description:    req.GetDescription(),
+		OwnerTeam:      req.GetOwnerTeam(),
+		Version:        req.GetVersion(),
+		Endpoints:      endpointsT(req.GetEndpoints()),
+		Labels:         req.GetLabels(),
+		Probe:          probeTFromProto(req.GetProbe()),
+		DependsOn:      req.GetDependsOn(),
+
+		ResourceVersion: req.GetResourceVersion(),
+	}
+
+	if err := validate(ctx, s.Validator, s.Bundles, reqT); err != nil {
+		return nil, err
+	}
+
+	resourceVersion, err := expectedResourceVersion(ctx, req.GetResourceVersion())
+	if err != nil {
+		return nil, resourceVersionError(err)
+	}
+
+	req.ResourceVersion = resourceVersion
+
+	resp, err := s.GRPCExampleHandlers.UpdateService(ctx, req)
+	if err != nil {
+		return nil, GRPCDomainError(err)
+	}
+
+	return resp, nil
+}
+
+// patchServiceT validates fields regardless of the mask, unmasked ones are zero and pass.
+type patchServiceT struct {
+	ServiceName     string            `validate:"required,serviceName"`
+	NewServiceName  string            `validate:"omitempty,serviceName"                     proto:"Service.ServiceName"`
+	Description     string            `validate:"max=1024"                                  proto:"Service.Description"`
+	OwnerTeam       string            `validate:"max=128"                                   proto:"Service.OwnerTeam"`
+	Version         string            `validate:"omitempty,semver"                          proto:"Service.Version"`
+	Endpoints       []endpointT       `validate:"max=64,dive"                               proto:"Service.Endpoints"`
+	Labels          map[string]string `validate:"max=64,dive,keys,labelKey,endkeys,max=256" proto:"Service.Labels"`
+	Probe           *probeT           `validate:"omitempty"                                 proto:"Service.Probe"`
+	DependsOn       []string          `validate:"max=64,unique,dive,serviceName"            proto:"Service.DependsOn"`
+	ResourceVersion int64             `validate:"gte=0"`
+}
+
+func (s *Server) PatchService(
+	ctx context.Context, req *exampleGRPC.PatchServiceRequest,
+) (*exampleGRPC.PatchServiceResponse, error) {
+	patch := req.GetService()
+
+	reqT := patchServiceT{
+		ServiceName:     req.GetServiceName(),
+		NewServiceName:  patch.GetServiceName(),
+		Description:     patch.GetDescription(),
+		OwnerTeam:       patch.GetOwnerTeam(),
+		Version:         patch.GetVersion(),
+		Endpoints:       endpointsT(patch.GetEndpoints()),
+		Labels:          patch.GetLabels(),
+		Probe:           probeTFromProto(patch.GetProbe()),
+		DependsOn:       patch.GetDependsOn(),
+		ResourceVersion: req.GetResourceVersion(),
+	}
+
+	if err := validate(ctx, s.Validator, s.Bundles, reqT); err != nil {
+		return nil, err
+	}
+
+	resourceVersion, err := expectedResourceVersion(ctx, req.GetResourceVersion())
+	if err != nil {
+		return nil, resourceVersionError(err)
+	}
+
+	req.ResourceVersion = resourceVersion
+
+	resp, err := s.GRPCExampleHandlers.PatchService(ctx, req)
+	if err != nil {
+		return nil, GRPCDomainError(err)
+	}
+
+	return resp, nil
+}
+
+// badRequest lists field violations in the form clients render next to the offending fields.
+func badRequest(violations []exampleSvc.FieldViolation) *errdetails.BadRequest {
+	details := &errdetails.BadRequest{
+		FieldViolations: make([]*errdetails.BadRequest_FieldViolation, 0, len(violations)),
+	}
+
+	for _, violation := range violations {
+		details.FieldViolations = append(details.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       violation.Field,
+			Description: violation.Description,
+		})
+	}
+
+	return details
+}
+
+type heartbeatT struct {
+	ServiceName string `validate:"required,serviceName"`
+}
+
+func (s *Server) Heartbeat(
+	ctx context.Context, req *exampleGRPC.HeartbeatRequest,
+) (*exampleGRPC.HeartbeatResponse, error) {
+	reqT := heartbeatT{
+		ServiceName: req.GetServiceName(),
+	}
+
+	if err := validate(ctx, s.Validator, s.Bundles, reqT); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.GRPCExampleHandlers.Heartbeat(ctx, req)
+	if err != nil {
+		return nil, GRPCDomainError(err)
+	}
+
+	return resp, nil
+}
+
+type registerInstanceT struct {
+	ServiceName string        `validate:"required,serviceName"`
+	InstanceID  string        `validate:"required,instanceID"      proto:"InstanceId"`
+	Endpoints   []endpointT   `validate:"min=1,max=64,dive"`
+	TTL         time.Duration `validate:"omitempty,min=1s,max=24h" proto:"Ttl"`
+}
+
+func (s *Server) RegisterInstance(
+	ctx context.Context, req *exampleGRPC.RegisterInstanceRequest,
+) (*exampleGRPC.RegisterInstanceResponse, error) {
+	reqT := registerInstanceT{
+		ServiceName: req.GetServiceName(),
+		InstanceID:  req.GetInstanceId(),
+		Endpoints:   endpointsT(req.GetEndpoints()),
+		TTL:         req.GetTtl().AsDuration(),
+	}
+
+	if err := validate(ctx, s.Validator, s.Bundles, reqT); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.GRPCExampleHandlers.RegisterInstance(ctx, req)
+	if err != nil {
+		return nil, GRPCDomainError(err)
+	}
+
+	return resp, nil
+}
+
+type listInstancesT struct {
+	ServiceName string `validate:"required,serviceName"`
+}
+
+func (s *Server) ListInstances(
+	ctx context.Context, req *exampleGRPC.ListInstancesRequest,
+) (*exampleGRPC.ListInstancesResponse, error) {
+	reqT := listInstancesT{
+		ServiceName: req.GetServiceName(),
+	}
+
+	if err := validate(ctx, s.Validator, s.Bundles, reqT); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.GRPCExampleHandlers.ListInstances(ctx, req)
+	if err != nil {
+		return nil, GRPCDomainError(err)
+	}
+
+	return resp, nil
+}
+
+type instanceT struct {
+	ServiceName string `validate:"required,serviceName"`
+	InstanceID  string `validate:"required,instanceID"  proto:"InstanceId"`
+}
+
+func (s *Server) InstanceHeartbeat(
+	ctx context.Context, req *exampleGRPC.InstanceHeartbeatRequest,
+) (*exampleGRPC.InstanceHeartbeatResponse, error) {
+	reqT := instanceT{
+		ServiceName: req.GetServiceName(),
+		InstanceID:  req.GetInstanceId(),
+	}
+
+	if err := validate(ctx, s.Validator, s.Bundles, reqT); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.GRPCExampleHandlers.InstanceHeartbeat(ctx, req)
+	if err != nil {
+		return nil, GRPCDomainError(err)
 	}
 
 	return resp, nil
 }
 
-func GRPCUnauthorizedError[T GRPCErrors](reason T, err error) error {
-	return gRPCError(codes.Unauthenticated, reason, err)
+func (s *Server) UnregisterInstance(
+	ctx context.Context, req *exampleGRPC.UnregisterInstanceRequest,
+) (*emptypb.Empty, error) {
+	reqT := instanceT{
+		ServiceName: req.GetServiceName(),
+		InstanceID:  req.GetInstanceId(),
+	}
+
+	if err := validate(ctx, s.Validator, s.Bundles, reqT); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.GRPCExampleHandlers.UnregisterInstance(ctx, req)
+	if err != nil {
+		return nil, GRPCDomainError(err)
+	}
+
+	return resp, nil
 }
 
-func GRPCValidationError[T GRPCErrors](reason T, err error) error {
-	return gRPCError(codes.InvalidArgument, reason, err)
+type unregisterServiceT struct {
+	ServiceName     string `validate:"required,serviceName"`
+	ResourceVersion int64  `validate:"gte=0"`
 }
 
-func GRPCBusinessError[T GRPCErrors](reason T, err error) error {
-	return gRPCError(codes.FailedPrecondition, reason, err)
+type getServiceHistoryT struct {
+	ServiceName string    `validate:"required,serviceName"`
+	PageSize    int32     `validate:"gte=0,lte=1000"`
+	From        time.Time `validate:"-"`
+	To          time.Time `validate:"omitempty,gtfield=From"`
 }
 
-func GRPCUnknownError[T GRPCErrors](reason T, err error) error {
-	return gRPCError(codes.Unknown, reason, err)
+func (s *Server) GetServiceHistory(
+	ctx context.Context, req *exampleGRPC.GetServiceHistoryRequest,
+) (*exampleGRPC.GetServiceHistoryResponse, error) {
+	reqT := getServiceHistoryT{
+		ServiceName: req.GetServiceName(),
+		PageSize:    req.GetPageSize(),
+		From:        optionalTime(req.GetFrom()),
+		To:          optionalTime(req.GetTo()),
+	}
+
+	if err := validate(ctx, s.Validator, s.Bundles, reqT); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.GRPCExampleHandlers.GetServiceHistory(ctx, req)
+	if err != nil {
+		return nil, GRPCDomainError(err)
+	}
+
+	return resp, nil
+}
+
+type dependenciesT struct {
+	ServiceName string                          `validate:"required,serviceName"`
+	Direction   exampleGRPC.DependencyDirection `validate:"protoEnum"`
+	MaxDepth    int32                           `validate:"gte=0,lte=100"`
 }
 
-func GRPCCustomError[T GRPCErrors](code codes.Code, reason T, err error) error {
+func (s *Server) GetServiceDependencies(
+	ctx context.Context, req *exampleGRPC.GetServiceDependenciesRequest,
+) (*exampleGRPC.GetServiceDependenciesResponse, error) {
+	reqT := dependenciesT{
+		ServiceName: req.GetServiceName(),
+		Direction:   req.GetDirection(),
+		MaxDepth:    req.GetMaxDepth(),
+	}
+
+	if err := validate(ctx, s.Validator, s.Bundles, reqT); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.GRPCExampleHandlers.GetServiceDependencies(ctx, req)
+	if err != nil {
+		return nil, GRPCDomainError(err)
+	}
+
+	return resp, nil
+}
+
+type dependencyGraphT struct {
+	ServiceName string                          `validate:"omitempty,serviceName"`
+	Direction   exampleGRPC.DependencyDirection `validate:"protoEnum"`
+	MaxDepth    int32                           `validate:"gte=0,lte=100"`
+	Format      exampleGRPC.GraphFormat         `validate:"protoEnum"`
+}
+
+func (s *Server) GetDependencyGraph(
+	ctx context.Context, req *exampleGRPC.GetDependencyGraphRequest,
+) (*exampleGRPC.GetDependencyGraphResponse, error) {
+	reqT := dependencyGraphT{
+		ServiceName: req.GetServiceName(),
+		Direction:   req.GetDirection(),
+		MaxDepth:    req.GetMaxDepth(),
+		Format:      req.GetFormat(),
+	}
+
+	if err := validate(ctx, s.Validator, s.Bundles, reqT); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.GRPCExampleHandlers.GetDependencyGraph(ctx, req)
+	if err != nil {
+		return nil, GRPCDomainError(err)
+	}
+
+	return resp, nil
+}
+
+func (s *Server) UnregisterService(
+	ctx context.Context, req *exampleGRPC.UnregisterServiceRequest,
+) (*emptypb.Empty, error) {
+	reqT := unregisterServiceT{
+		ServiceName:     req.GetServiceName(),
+		ResourceVersion: req.GetResourceVersion(),
+	}
+
+	if err := validate(ctx, s.Validator, s.Bundles, reqT); err != nil {
+		return nil, err
+	}
+
+	resourceVersion, err := expectedResourceVersion(ctx, req.GetResourceVersion())
+	if err != nil {
+		return nil, resourceVersionError(err)
+	}
+
+	req.ResourceVersion = resourceVersion
+
+	resp, err := s.GRPCExampleHandlers.UnregisterService(ctx, req)
+	if err != nil {
+		return nil, GRPCDomainError(err)
+	}
+
+	return resp, nil
+}
+
+// expectedResourceVersion returns the resource version a write is based on: the one of the request,
+// or the one of an If-Match header, which the gateway forwards as metadata. Both must agree when set.
+// Updates and deletions are never unconditional, a write without a version fails with ErrResourceVersionRequired.
+func expectedResourceVersion(ctx context.Context, fromRequest int64) (int64, error) {
+	values := metadata.ValueFromIncomingContext(ctx, etag.IfMatchHeader)
+
+	var fromHeader int64
+
+	switch len(values) {
+	case 0:
+	case 1:
+		parsed, err := etag.ParseIfMatch(values[0])
+		if err != nil {
+			return 0, err
+		}
+
+		fromHeader = parsed
+	default:
+		return 0, fmt.Errorf("%w | a single entity tag is expected", etag.ErrInvalidIfMatch)
+	}
+
+	switch {
+	case fromRequest == 0 && fromHeader == 0:
+		return 0, ErrResourceVersionRequired
+	case fromRequest == 0:
+		return fromHeader, nil
+	case fromHeader != 0 && fromHeader != fromRequest:
+		return 0, fmt.Errorf("%w | it does not match ResourceVersion", etag.ErrInvalidIfMatch)
+	default:
+		return fromRequest, nil
+	}
+}
+
+// resourceVersionError is the status of an error of expectedResourceVersion.
+func resourceVersionError(err error) error {
+	if errors.Is(err, ErrResourceVersionRequired) {
+		return GRPCError(reasons.ResourceVersionRequired, err)
+	}
+
+	return GRPCError(reasons.InvalidIfMatch, err)
+}
+
+// optionalTime keeps an unset timestamp zero instead of the unix epoch.
+func optionalTime(t *timestamppb.Timestamp) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+
+	return t.AsTime()
+}
+
+func probeTFromProto(probe *exampleGRPC.Probe) *probeT {
+	if probe == nil {
+		return nil
+	}
+
+	return &probeT{
+		Protocol: probe.GetProtocol(),
+		Address:  probe.GetAddress(),
+	}
+}
+
+func endpointsT(endpoints []*exampleGRPC.Endpoint) []endpointT {
+	result := make([]endpointT, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		result = append(result, endpointT{
+			Protocol: endpoint.GetProtocol(),
+			Address:  endpoint.GetAddress(),
+		})
+	}
+
+	return result
+}
+
+// GRPCError builds the status of reason with its code from the catalog, ErrorInfo with reason comes first
+// and details follow it.
+func GRPCError(reason reasons.Reason, serviceErr error, details ...protoadapt.MessageV1) error {
+	return gRPCError(reason.Code(), reason, serviceErr, details...)
+}
+
+// GRPCValidationError is GRPCError for the reasons the catalog files under INVALID_ARGUMENT.
+func GRPCValidationError(reason reasons.Reason, err error, details ...protoadapt.MessageV1) error {
+	return GRPCError(reason, err, details...)
+}
+
+// GRPCBusinessError is GRPCError for the reasons the catalog files under FAILED_PRECONDITION.
+func GRPCBusinessError(reason reasons.Reason, err error, details ...protoadapt.MessageV1) error {
+	return GRPCError(reason, err, details...)
+}
+
+// GRPCUnauthorizedError is GRPCError for the reasons the catalog files under UNAUTHENTICATED.
+func GRPCUnauthorizedError(reason reasons.Reason, err error) error {
+	return GRPCError(reason, err)
+}
+
+// GRPCCustomError reports err with reason under code instead of the code of the reason in the catalog.
+func GRPCCustomError(code codes.Code, reason reasons.Reason, err error) error {
 	return gRPCError(code, reason, err)
 }
 
-func gRPCError[T GRPCErrors](code codes.Code, reason T, serviceErr error) error {
+func gRPCError(code codes.Code, reason reasons.Reason, serviceErr error, details ...protoadapt.MessageV1) error {
 	if serviceErr == nil {
 		serviceErr = errors.New("error not set")
 	}
 
 	st, err := status.Newf(code, "error: %v", serviceErr.Error()).WithDetails(
-		&errdetails.ErrorInfo{
-			Reason:   reason.Error(),
-			Domain:   domain,
-			Metadata: nil,
-		},
+		append([]protoadapt.MessageV1{
+			&errdetails.ErrorInfo{
+				Reason:   string(reason),
+				Domain:   domain,
+				Metadata: nil,
+			},
+		}, details...)...,
 	)
 	if err != nil {
 		panic(fmt.Sprintf("unexpected error attaching metadata: %v", err))
@@ -261,10 +1298,11 @@ func gRPCError[T GRPCErrors](code codes.Code, reason T, serviceErr error) error
 	return st.Err()
 }
 
-func validate[T GRPCErrors](v *validator.Validate, req any, reason T) error {
-	if err := v.Struct(req); err != nil {
-		return GRPCValidationError(reason, err)
+// GRPCDomainError reports err with the reason registered for it in DomainErrors, UNKNOWN when there is none.
+func GRPCDomainError(err error) error {
+	if st, ok := DomainErrors.status(err); ok {
+		return st
 	}
 
-	return nil
+	return GRPCError(reasons.Unknown, err)
 }
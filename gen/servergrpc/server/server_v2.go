@@ -0,0 +1,331 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/ingvarmattis/example/gen/reasons"
+	exampleGRPC "github.com/ingvarmattis/example/gen/servergrpc/example"
+	exampleV2GRPC "github.com/ingvarmattis/example/gen/servergrpc/examplev2"
+	"github.com/ingvarmattis/example/src/deprecation"
+	"github.com/ingvarmattis/example/src/etag"
+	"github.com/ingvarmattis/example/src/i18n"
+	exampleSvc "github.com/ingvarmattis/example/src/services/example"
+)
+
+// v1DeprecatedSince is when the registry methods of v1 got their replacements in v2.
+var v1DeprecatedSince = time.Date(2026, time.October, 17, 0, 0, 0, 0, time.UTC)
+
+// DeprecatedMethods are the v1 methods replaced by v2. Methods without a replacement are not deprecated yet.
+var DeprecatedMethods = deprecation.Notices{
+	exampleGRPC.ExampleService_RegisterService_FullMethodName: {
+		Since: v1DeprecatedSince, Successor: exampleV2GRPC.ExampleService_CreateService_FullMethodName,
+	},
+	exampleGRPC.ExampleService_ListServices_FullMethodName: {
+		Since: v1DeprecatedSince, Successor: exampleV2GRPC.ExampleService_ListServices_FullMethodName,
+	},
+	exampleGRPC.ExampleService_GetService_FullMethodName: {
+		Since: v1DeprecatedSince, Successor: exampleV2GRPC.ExampleService_GetService_FullMethodName,
+	},
+	exampleGRPC.ExampleService_UpdateService_FullMethodName: {
+		Since: v1DeprecatedSince, Successor: exampleV2GRPC.ExampleService_UpdateService_FullMethodName,
+	},
+	exampleGRPC.ExampleService_PatchService_FullMethodName: {
+		Since: v1DeprecatedSince, Successor: exampleV2GRPC.ExampleService_UpdateService_FullMethodName,
+	},
+	exampleGRPC.ExampleService_Heartbeat_FullMethodName: {
+		Since: v1DeprecatedSince, Successor: exampleV2GRPC.ExampleService_RenewLease_FullMethodName,
+	},
+	exampleGRPC.ExampleService_UnregisterService_FullMethodName: {
+		Since: v1DeprecatedSince, Successor: exampleV2GRPC.ExampleService_DeleteService_FullMethodName,
+	},
+}
+
+type GRPCExampleV2Handlers interface {
+	CreateService(ctx context.Context, in *exampleV2GRPC.CreateServiceRequest) (*exampleV2GRPC.Service, error)
+	ListServices(
+		ctx context.Context, in *exampleV2GRPC.ListServicesRequest,
+	) (*exampleV2GRPC.ListServicesResponse, error)
+	GetService(ctx context.Context, in *exampleV2GRPC.GetServiceRequest) (*exampleV2GRPC.Service, error)
+	UpdateService(ctx context.Context, in *exampleV2GRPC.UpdateServiceRequest) (*exampleV2GRPC.Service, error)
+	RenewLease(ctx context.Context, in *exampleV2GRPC.RenewLeaseRequest) (*exampleV2GRPC.Service, error)
+	DeleteService(ctx context.Context, in *exampleV2GRPC.DeleteServiceRequest) (*emptypb.Empty, error)
+}
+
+// ServerV2 validates the calls of the v2 API. NewServer serves it next to v1, on the same listeners.
+type ServerV2 struct {
+	exampleV2GRPC.UnimplementedExampleServiceServer
+
+	GRPCExampleV2Handlers GRPCExampleV2Handlers
+
+	Validator *validator.Validate
+	Bundles   *i18n.Bundles
+}
+
+var (
+	// updatableFieldsV2 are the paths UpdateServiceRequest.UpdateMask accepts, Probe subfields select the whole probe.
+	updatableFieldsV2 = []string{"Description", "OwnerTeam", "Version", "Endpoints", "Labels", "Probe", "DependsOn"}
+	// ignoredFieldsV2 are dropped from the mask, so that a service read with GetService can be sent back as a whole.
+	ignoredFieldsV2 = []string{"Name", "Lease", "State", "CreateTime", "UpdateTime", "Etag"}
+)
+
+type endpointV2T struct {
+	Protocol exampleV2GRPC.EndpointProtocol `validate:"required,protoEnum"`
+	Address  string                         `validate:"required,hostname_port"`
+}
+
+type probeV2T struct {
+	Protocol exampleV2GRPC.EndpointProtocol `validate:"required,protoEnum"`
+	Address  string                         `validate:"required,max=2048"`
+}
+
+// serviceV2T validates the writable fields of a v2 service, output only fields are ignored.
+type serviceV2T struct {
+	Description string            `validate:"max=1024"`
+	OwnerTeam   string            `validate:"max=128"`
+	Version     string            `validate:"omitempty,semver"`
+	Endpoints   []endpointV2T     `validate:"max=64,dive"`
+	Labels      map[string]string `validate:"max=64,dive,keys,labelKey,endkeys,max=256"`
+	TTL         time.Duration     `validate:"omitempty,min=1s,max=24h" proto:"Lease.Ttl"`
+	Probe       *probeV2T         `validate:"omitempty"`
+	DependsOn   []string          `validate:"max=64,unique,dive,serviceResourceName"`
+}
+
+type createServiceV2T struct {
+	ServiceID string `validate:"required,serviceName" proto:"ServiceId"`
+	Service   serviceV2T
+}
+
+func (s *ServerV2) CreateService(
+	ctx context.Context, req *exampleV2GRPC.CreateServiceRequest,
+) (*exampleV2GRPC.Service, error) {
+	reqT := createServiceV2T{
+		ServiceID: req.GetServiceId(),
+		Service:   serviceV2TFromProto(req.GetService()),
+	}
+
+	if err := validate(ctx, s.Validator, s.Bundles, reqT); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.GRPCExampleV2Handlers.CreateService(ctx, req)
+	if err != nil {
+		return nil, GRPCDomainError(err)
+	}
+
+	return resp, nil
+}
+
+type listServicesV2T struct {
+	PageSize int32  `validate:"gte=0,lte=1000"`
+	Filter   string `validate:"max=4096"`
+	OrderBy  string `validate:"omitempty,oneof='Name' 'Name asc' 'Name desc'"`
+}
+
+func (s *ServerV2) ListServices(
+	ctx context.Context, req *exampleV2GRPC.ListServicesRequest,
+) (*exampleV2GRPC.ListServicesResponse, error) {
+	reqT := listServicesV2T{
+		PageSize: req.GetPageSize(),
+		Filter:   req.GetFilter(),
+		OrderBy:  req.GetOrderBy(),
+	}
+
+	if err := validate(ctx, s.Validator, s.Bundles, reqT); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.GRPCExampleV2Handlers.ListServices(ctx, req)
+	if err != nil {
+		// the selector is the Filter of v2, not the LabelSelector DomainErrors names
+		var selectorErr *exampleSvc.SelectorSyntaxError
+		if errors.As(err, &selectorErr) {
+			return nil, GRPCError(reasons.InvalidLabelSelector, err, selectorViolation("Filter", selectorErr))
+		}
+
+		return nil, GRPCDomainError(err)
+	}
+
+	return resp, nil
+}
+
+type serviceNameV2T struct {
+	Name string `validate:"required,serviceResourceName"`
+}
+
+func (s *ServerV2) GetService(
+	ctx context.Context, req *exampleV2GRPC.GetServiceRequest,
+) (*exampleV2GRPC.Service, error) {
+	if err := validate(ctx, s.Validator, s.Bundles, serviceNameV2T{Name: req.GetName()}); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.GRPCExampleV2Handlers.GetService(ctx, req)
+	if err != nil {
+		return nil, GRPCDomainError(err)
+	}
+
+	return resp, nil
+}
+
+type updateServiceV2T struct {
+	Name    string `validate:"required,serviceResourceName" proto:"Service.Name"`
+	Service serviceV2T
+}
+
+func (s *ServerV2) UpdateService(
+	ctx context.Context, req *exampleV2GRPC.UpdateServiceRequest,
+) (*exampleV2GRPC.Service, error) {
+	reqT := updateServiceV2T{
+		Name:    req.GetService().GetName(),
+		Service: serviceV2TFromProto(req.GetService()),
+	}
+
+	if err := validate(ctx, s.Validator, s.Bundles, reqT); err != nil {
+		return nil, err
+	}
+
+	paths, violations := updateMaskV2(req.GetUpdateMask().GetPaths())
+	if len(violations) > 0 {
+		return nil, GRPCError(reasons.InvalidUpdateMask,
+			&exampleSvc.InvalidUpdateMaskError{Violations: violations}, badRequest(violations),
+		)
+	}
+
+	if req.GetUpdateMask() != nil {
+		req.UpdateMask.Paths = paths
+	}
+
+	resourceVersion, err := expectedResourceVersionV2(ctx, req.GetService().GetEtag())
+	if err != nil {
+		return nil, resourceVersionError(err)
+	}
+
+	req.Service.Etag = etag.Format(resourceVersion)
+
+	resp, err := s.GRPCExampleV2Handlers.UpdateService(ctx, req)
+	if err != nil {
+		return nil, GRPCDomainError(err)
+	}
+
+	return resp, nil
+}
+
+func (s *ServerV2) RenewLease(
+	ctx context.Context, req *exampleV2GRPC.RenewLeaseRequest,
+) (*exampleV2GRPC.Service, error) {
+	if err := validate(ctx, s.Validator, s.Bundles, serviceNameV2T{Name: req.GetName()}); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.GRPCExampleV2Handlers.RenewLease(ctx, req)
+	if err != nil {
+		return nil, GRPCDomainError(err)
+	}
+
+	return resp, nil
+}
+
+func (s *ServerV2) DeleteService(
+	ctx context.Context, req *exampleV2GRPC.DeleteServiceRequest,
+) (*emptypb.Empty, error) {
+	if err := validate(ctx, s.Validator, s.Bundles, serviceNameV2T{Name: req.GetName()}); err != nil {
+		return nil, err
+	}
+
+	resourceVersion, err := expectedResourceVersionV2(ctx, req.GetEtag())
+	if err != nil {
+		return nil, resourceVersionError(err)
+	}
+
+	req.Etag = etag.Format(resourceVersion)
+
+	resp, err := s.GRPCExampleV2Handlers.DeleteService(ctx, req)
+	if err != nil {
+		return nil, GRPCDomainError(err)
+	}
+
+	return resp, nil
+}
+
+// updateMaskV2 drops the ignored paths of a mask and rejects the unknown ones.
+func updateMaskV2(paths []string) ([]string, []exampleSvc.FieldViolation) {
+	var (
+		updatable  []string
+		violations []exampleSvc.FieldViolation
+	)
+
+	for i, path := range paths {
+		field, _, _ := strings.Cut(path, ".")
+
+		if slices.Contains(ignoredFieldsV2, field) {
+			continue
+		}
+
+		if slices.Contains(updatableFieldsV2, field) && (field == "Probe" || field == path) {
+			updatable = append(updatable, path)
+			continue
+		}
+
+		violations = append(violations, exampleSvc.FieldViolation{
+			Field:       "UpdateMask.Paths[" + strconv.Itoa(i) + "]",
+			Description: fmt.Sprintf("%q cannot be updated, expected one of %s", path, strings.Join(updatableFieldsV2, ", ")),
+		})
+	}
+
+	return updatable, violations
+}
+
+// expectedResourceVersionV2 reads the resource version from the Etag of a request, then checks it against
+// If-Match the same way as v1 does for ResourceVersion.
+func expectedResourceVersionV2(ctx context.Context, fromRequest string) (int64, error) {
+	var resourceVersion int64
+
+	if fromRequest != "" {
+		parsed, err := etag.ParseIfMatch(fromRequest)
+		if err != nil {
+			return 0, fmt.Errorf("invalid Etag | %w", err)
+		}
+
+		resourceVersion = parsed
+	}
+
+	return expectedResourceVersion(ctx, resourceVersion)
+}
+
+func serviceV2TFromProto(service *exampleV2GRPC.Service) serviceV2T {
+	endpoints := make([]endpointV2T, 0, len(service.GetEndpoints()))
+	for _, endpoint := range service.GetEndpoints() {
+		endpoints = append(endpoints, endpointV2T{
+			Protocol: endpoint.GetProtocol(),
+			Address:  endpoint.GetAddress(),
+		})
+	}
+
+	var probe *probeV2T
+	if service.GetProbe() != nil {
+		probe = &probeV2T{
+			Protocol: service.GetProbe().GetProtocol(),
+			Address:  service.GetProbe().GetAddress(),
+		}
+	}
+
+	return serviceV2T{
+		Description: service.GetDescription(),
+		OwnerTeam:   service.GetOwnerTeam(),
+		Version:     service.GetVersion(),
+		Endpoints:   endpoints,
+		Labels:      service.GetLabels(),
+		TTL:         service.GetLease().GetTtl().AsDuration(),
+		Probe:       probe,
+		DependsOn:   service.GetDependsOn(),
+	}
+}
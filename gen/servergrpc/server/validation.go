@@ -0,0 +1,96 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+
+	"github.com/ingvarmattis/example/gen/reasons"
+	"github.com/ingvarmattis/example/src/i18n"
+)
+
+// validate reports every failed field of req in BadRequest details, so that clients can point at them.
+// With bundles the details describe the fields in the language of the caller. The message of the status
+// is for developers and joins their descriptions in the fallback language.
+func validate(ctx context.Context, v *validator.Validate, bundles *i18n.Bundles, req any) error {
+	return validateAt(ctx, v, bundles, req, reasons.InvalidRequest, "")
+}
+
+// validateAt is validate for a part of a request, such as a message of a stream, rejected with reason.
+// field is the path of the part, it prefixes the paths of the violations and the message.
+func validateAt(
+	ctx context.Context, v *validator.Validate, bundles *i18n.Bundles, req any, reason reasons.Reason, field string,
+) error {
+	err := v.Struct(req)
+	if err == nil {
+		return nil
+	}
+
+	var validationErrs validator.ValidationErrors
+	if !errors.As(err, &validationErrs) {
+		return GRPCError(reason, err)
+	}
+
+	var localized, fallback ut.Translator
+	if bundles != nil {
+		localized, fallback = bundles.Translator(bundles.Locale(ctx)), bundles.Translator(i18n.Fallback)
+	}
+
+	details := validationBadRequest(validationErrs, localized, field)
+
+	descriptions := make([]string, 0, len(validationErrs))
+	for _, violation := range validationBadRequest(validationErrs, fallback, field).GetFieldViolations() {
+		descriptions = append(descriptions, violation.GetDescription())
+	}
+
+	message := strings.Join(descriptions, "; ")
+	if field != "" {
+		message = field + ": " + message
+	}
+
+	return GRPCError(reason, errors.New(message), details)
+}
+
+// validationBadRequest names fields by their path in the request message, under field when it is set,
+// and the failed validation tag. Without a translator descriptions are the raw messages of the validator.
+func validationBadRequest(
+	errs validator.ValidationErrors, translator ut.Translator, field string,
+) *errdetails.BadRequest {
+	details := &errdetails.BadRequest{
+		FieldViolations: make([]*errdetails.BadRequest_FieldViolation, 0, len(errs)),
+	}
+
+	for _, fieldErr := range errs {
+		description := fieldErr.Error()
+		if translator != nil {
+			description = fieldErr.Translate(translator)
+		}
+
+		path := fieldPath(fieldErr.Namespace())
+		if field != "" {
+			path = field + "." + path
+		}
+
+		details.FieldViolations = append(details.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       path,
+			Description: description,
+			Reason:      fieldErr.Tag(),
+		})
+	}
+
+	return details
+}
+
+// fieldPath drops the name of the validated struct from a namespace, e.g. registerServiceT.Endpoints[0].Address.
+func fieldPath(namespace string) string {
+	_, path, found := strings.Cut(namespace, ".")
+	if !found {
+		return namespace
+	}
+
+	return path
+}
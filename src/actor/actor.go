@@ -0,0 +1,32 @@
+package actor
+
+import "context"
+
+const (
+	// Header is the request header callers name themselves with. It is not authenticated.
+	Header = "x-actor"
+
+	// Unknown is reported when nothing has set the actor of a context.
+	Unknown = "unknown"
+
+	// LeaseReaper expires and deletes registrations that missed their lease.
+	LeaseReaper = "system:lease-reaper"
+	// Bootstrap registers the service itself on startup.
+	Bootstrap = "system:bootstrap"
+)
+
+type actorKey struct{}
+
+// WithActor returns a copy of ctx carrying the name of whoever causes the changes made with it.
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// FromContext returns the actor set by WithActor, or Unknown.
+func FromContext(ctx context.Context) string {
+	if actor, ok := ctx.Value(actorKey{}).(string); ok && actor != "" {
+		return actor
+	}
+
+	return Unknown
+}
@@ -17,6 +17,8 @@ import (
 
 	"github.com/ingvarmattis/example/src/config"
 	"github.com/ingvarmattis/example/src/log"
+	"github.com/ingvarmattis/example/src/pglisten"
+	exampleRepo "github.com/ingvarmattis/example/src/repositories/example"
 )
 
 const NotOperational = "noop"
@@ -25,6 +27,8 @@ type Env struct {
 	Config *config.Config
 
 	PGXPool *pgxpool.Pool
+	// ChangesListener delivers revisions of registry changes made by any replica.
+	ChangesListener *pglisten.Listener
 
 	Logger *log.Zap
 
@@ -51,11 +55,12 @@ func NewENV(ctx context.Context) (*Env, error) {
 	}
 
 	return &Env{
-		Config:        cfg,
-		PGXPool:       pgPool,
-		Logger:        logger,
-		Tracer:        tracer,
-		TraceProvider: traceProvider,
+		Config:          cfg,
+		PGXPool:         pgPool,
+		ChangesListener: pglisten.NewListener(pgPool, exampleRepo.ChangesChannel, logger),
+		Logger:          logger,
+		Tracer:          tracer,
+		TraceProvider:   traceProvider,
 	}, nil
 }
 
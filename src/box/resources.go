@@ -2,6 +2,7 @@ package box
 
 import (
 	"context"
+	"crypto/x509"
 	"fmt"
 
 	"github.com/go-playground/validator/v10"
@@ -9,10 +10,15 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/ingvarmattis/example/gen/servergrpc/server"
+	"github.com/ingvarmattis/example/src/certs"
+	"github.com/ingvarmattis/example/src/cors"
+	"github.com/ingvarmattis/example/src/i18n"
 	"github.com/ingvarmattis/example/src/interceptors"
+	"github.com/ingvarmattis/example/src/probe"
 	exampleRepo "github.com/ingvarmattis/example/src/repositories/example"
 	"github.com/ingvarmattis/example/src/rpctransport"
 	exampleRPC "github.com/ingvarmattis/example/src/rpctransport/example"
+	exampleV2RPC "github.com/ingvarmattis/example/src/rpctransport/examplev2"
 	"github.com/ingvarmattis/example/src/services"
 	exampleSvc "github.com/ingvarmattis/example/src/services/example"
 )
@@ -35,24 +41,54 @@ type Resources struct {
 	GRPCServer    *server.Server
 	TelegramBot   TelegramBotInterface
 	MetricsServer *server.MetricsServer
+	// Certificates of the servers, nil when they are plaintext
+	Certificates *certs.Reloader
 }
 
 func NewResources(ctx context.Context, envBox *Env) (*Resources, error) {
-	exampleService, err := exampleSvc.NewService(ctx, exampleRepo.NewPostgres(envBox.PGXPool))
+	exampleService, err := exampleSvc.NewService(
+		ctx, envBox.Logger, exampleRepo.NewPostgres(envBox.PGXPool), envBox.ChangesListener,
+		probe.NewChecker(), &exampleSvc.ProbeParams{
+			Interval:           envBox.Config.ProbeConfig.Interval,
+			Timeout:            envBox.Config.ProbeConfig.Timeout,
+			Concurrency:        envBox.Config.ProbeConfig.Concurrency,
+			UnhealthyThreshold: envBox.Config.ProbeConfig.UnhealthyThreshold,
+			DegradedLatency:    envBox.Config.ProbeConfig.DegradedLatency,
+		},
+	)
 	if err != nil {
 		return nil, fmt.Errorf("cannot create example service | %w", err)
 	}
 
 	validator := rpctransport.MustValidate()
-	unaryInterceptors := provideUnaryInterceptors(envBox)
-	streamInterceptors := provideStreamInterceptors()
+	bundles := i18n.MustBundles(validator)
+
+	certificates, err := provideCertificates(envBox)
+	if err != nil {
+		return nil, err
+	}
+
+	corsPolicies, err := provideCORSPolicies(envBox)
+	if err != nil {
+		return nil, err
+	}
+
+	unaryInterceptors := provideUnaryInterceptors(envBox, bundles, certificates)
+	streamInterceptors := provideStreamInterceptors(envBox, bundles, certificates)
 
 	telegramBot, err := provideTelegramBot(envBox)
 	if err != nil {
 		return nil, err
 	}
 
-	grpcServer := provideGRPCServer(ctx, envBox, exampleService, validator, unaryInterceptors, streamInterceptors)
+	grpcServer, err := provideGRPCServer(
+		ctx, envBox, exampleService, validator, bundles, certificates, corsPolicies,
+		unaryInterceptors, streamInterceptors,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	metricsServer := provideMetricsServer(envBox)
 
 	return &Resources{
@@ -66,6 +102,7 @@ func NewResources(ctx context.Context, envBox *Env) (*Resources, error) {
 		GRPCServer:    grpcServer,
 		TelegramBot:   telegramBot,
 		MetricsServer: metricsServer,
+		Certificates:  certificates,
 	}, nil
 }
 
@@ -74,10 +111,18 @@ func provideGRPCServer(
 	envBox *Env,
 	exampleService *exampleSvc.Service,
 	validator *validator.Validate,
+	bundles *i18n.Bundles,
+	certificates *certs.Reloader,
+	corsPolicies *cors.Policies,
 	unaryInterceptors []grpc.UnaryServerInterceptor,
 	streamInterceptors []grpc.StreamServerInterceptor,
-) *server.Server {
-	return server.NewServer(
+) (*server.Server, error) {
+	gatewayMode, err := server.ParseGatewayMode(envBox.Config.HTTPServerConfig.GatewayMode)
+	if err != nil {
+		return nil, fmt.Errorf("provide grpc server | %w", err)
+	}
+
+	grpcServer, err := server.NewServer(
 		ctx,
 		envBox.Config.GRPCServerListenPort,
 		&server.NewServerOptions{
@@ -85,12 +130,75 @@ func provideGRPCServer(
 			GRPCExampleHandlers: &exampleRPC.Handlers{
 				Service: services.SvcLayer{ExampleService: exampleService},
 			},
+			GRPCExampleV2Handlers: &exampleV2RPC.Handlers{
+				Service: services.SvcLayer{ExampleService: exampleService},
+			},
 			Validator:          validator,
+			Bundles:            bundles,
 			Logger:             envBox.Logger,
 			UnaryInterceptors:  unaryInterceptors,
 			StreamInterceptors: streamInterceptors,
+			HTTPTimeouts: server.HTTPTimeouts{
+				ReadHeader: envBox.Config.HTTPServerConfig.ReadHeaderTimeout,
+				Read:       envBox.Config.HTTPServerConfig.ReadTimeout,
+				Write:      envBox.Config.HTTPServerConfig.WriteTimeout,
+				Idle:       envBox.Config.HTTPServerConfig.IdleTimeout,
+			},
+			GatewayMode: gatewayMode,
+			CORS:        corsPolicies,
+			TLS:         certificates,
 		},
 	)
+	if err != nil {
+		return nil, fmt.Errorf("provide grpc server | %w", err)
+	}
+
+	return grpcServer, nil
+}
+
+func provideCORSPolicies(envBox *Env) (*cors.Policies, error) {
+	if !envBox.Config.CORSConfig.Enabled {
+		return nil, nil //nolint:nilnil // no policies, no cross-origin access
+	}
+
+	policies, err := cors.NewPolicies(cors.Policy{
+		AllowedOrigins:   envBox.Config.CORSConfig.AllowedOrigins,
+		AllowCredentials: envBox.Config.CORSConfig.AllowCredentials,
+		AllowedMethods:   envBox.Config.CORSConfig.AllowedMethods,
+		AllowedHeaders:   envBox.Config.CORSConfig.AllowedHeaders,
+		ExposedHeaders:   envBox.Config.CORSConfig.ExposedHeaders,
+		MaxAge:           envBox.Config.CORSConfig.MaxAge,
+	}, envBox.Config.CORSConfig.Routes)
+	if err != nil {
+		return nil, fmt.Errorf("provide cors policies | %w", err)
+	}
+
+	return policies, nil
+}
+
+func provideCertificates(envBox *Env) (*certs.Reloader, error) {
+	if !envBox.Config.TLSConfig.Enabled {
+		return nil, nil //nolint:nilnil // no certificates, plaintext servers
+	}
+
+	reloader, err := certs.NewReloader(
+		envBox.Logger,
+		envBox.Config.TLSConfig.CertFile, envBox.Config.TLSConfig.KeyFile, envBox.Config.TLSConfig.ClientCAFile,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("provide certificates | %w", err)
+	}
+
+	return reloader, nil
+}
+
+// gatewayMatcher recognizes the calls the gateway makes on behalf of its clients.
+func gatewayMatcher(certificates *certs.Reloader) func(*x509.Certificate) bool {
+	if certificates == nil {
+		return func(*x509.Certificate) bool { return false }
+	}
+
+	return certificates.IsOwn
 }
 
 func provideMetricsServer(envBox *Env) *server.MetricsServer {
@@ -117,17 +225,36 @@ func provideTelegramBot(envBox *Env) (TelegramBotInterface, error) {
 	return bot, nil
 }
 
-func provideUnaryInterceptors(envBox *Env) []grpc.UnaryServerInterceptor {
+func provideUnaryInterceptors(
+	envBox *Env, bundles *i18n.Bundles, certificates *certs.Reloader,
+) []grpc.UnaryServerInterceptor {
 	logger := envBox.Logger.WithFields(zap.String("type", "unary"))
 
 	return []grpc.UnaryServerInterceptor{
 		interceptors.UnaryServerMetricsInterceptor(envBox.Config.MetricsConfig.Enabled, envBox.Config.ServiceName),
 		interceptors.UnaryServerTraceInterceptor(envBox.Tracer, envBox.Config.ServiceName),
-		interceptors.UnaryServerLogInterceptor(logger, envBox.Config.Debug),
+		interceptors.UnaryServerLogInterceptor(logger, envBox.Config.Debug, server.DeprecatedMethods),
+		interceptors.UnaryServerLocalizationInterceptor(bundles),
 		interceptors.UnaryServerPanicsInterceptor(logger, envBox.Config.ServiceName),
+		interceptors.UnaryServerIdentityInterceptor(gatewayMatcher(certificates)),
+		interceptors.UnaryServerActorInterceptor(gatewayMatcher(certificates)),
+		interceptors.UnaryServerDeprecationInterceptor(server.DeprecatedMethods),
 	}
 }
 
-func provideStreamInterceptors() []grpc.StreamServerInterceptor {
-	return []grpc.StreamServerInterceptor{}
+func provideStreamInterceptors(
+	envBox *Env, bundles *i18n.Bundles, certificates *certs.Reloader,
+) []grpc.StreamServerInterceptor {
+	logger := envBox.Logger.WithFields(zap.String("type", "stream"))
+
+	return []grpc.StreamServerInterceptor{
+		interceptors.StreamServerMetricsInterceptor(envBox.Config.MetricsConfig.Enabled, envBox.Config.ServiceName),
+		interceptors.StreamServerTraceInterceptor(envBox.Tracer, envBox.Config.ServiceName),
+		interceptors.StreamServerLogInterceptor(logger, server.DeprecatedMethods),
+		interceptors.StreamServerLocalizationInterceptor(bundles),
+		interceptors.StreamServerPanicsInterceptor(logger, envBox.Config.ServiceName),
+		interceptors.StreamServerIdentityInterceptor(gatewayMatcher(certificates)),
+		interceptors.StreamServerActorInterceptor(gatewayMatcher(certificates)),
+		interceptors.StreamServerDeprecationInterceptor(server.DeprecatedMethods),
+	}
 }
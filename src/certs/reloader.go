@@ -0,0 +1,281 @@
+package certs
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/ingvarmattis/example/src/log"
+)
+
+var (
+	ErrNoCertificate = errors.New("no certificate presented")
+	ErrNoClientCAs   = errors.New("no certificates in the client ca bundle")
+)
+
+// Reloader serves a certificate and, for mutual TLS, a bundle of client CAs from files. Watch picks up
+// rotated files, handshakes after that use them without a restart.
+type Reloader struct {
+	certFile     string
+	keyFile      string
+	clientCAFile string
+
+	logger *log.Zap
+
+	mu          sync.RWMutex
+	certificate *tls.Certificate
+	// loaded are the unexpired certificates loaded so far, connections made before a rotation keep theirs
+	loaded    []*x509.Certificate
+	clientCAs *x509.CertPool
+	// modTimes are of the files the loaded certificates were read from, in the order of files
+	modTimes []time.Time
+}
+
+// NewReloader loads the certificate and the key, and the client CAs unless clientCAFile is empty.
+func NewReloader(logger *log.Zap, certFile, keyFile, clientCAFile string) (*Reloader, error) {
+	r := &Reloader{
+		certFile:     certFile,
+		keyFile:      keyFile,
+		clientCAFile: clientCAFile,
+		logger:       logger,
+		mu:           sync.RWMutex{},
+		certificate:  nil,
+		loaded:       nil,
+		clientCAs:    nil,
+		modTimes:     nil,
+	}
+
+	if err := r.reload(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// MutualTLS reports whether clients have to present a certificate.
+func (r *Reloader) MutualTLS() bool {
+	return r.clientCAFile != ""
+}
+
+// ServerConfig is the TLS configuration of a listener negotiating nextProtos. With mutual TLS it requires
+// a client certificate signed by one of the client CAs, or the certificate of the server itself,
+// the one the gateway calls gRPC with.
+func (r *Reloader) ServerConfig(nextProtos ...string) *tls.Config {
+	config := &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		NextProtos:     nextProtos,
+		GetCertificate: r.getCertificate,
+		ClientAuth:     tls.NoClientCert,
+	}
+
+	if r.MutualTLS() {
+		// verified by verifyClient against the current bundle, a config holds a single one
+		config.ClientAuth = tls.RequireAnyClientCert
+		config.VerifyConnection = r.verifyClient
+	}
+
+	return config
+}
+
+// LoopbackConfig is the TLS configuration the gateway dials the gRPC listener of the same process with.
+// The listener has to present the certificate of the reloader, whatever its names are.
+func (r *Reloader) LoopbackConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// verified by VerifyConnection, the certificate is pinned instead of checked against roots
+		InsecureSkipVerify: true, //nolint:gosec // pinned by VerifyConnection
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return r.current(), nil
+		},
+		VerifyConnection: func(state tls.ConnectionState) error {
+			own := r.current()
+			if len(state.PeerCertificates) == 0 || !bytes.Equal(own.Certificate[0], state.PeerCertificates[0].Raw) {
+				return errors.New("the grpc listener presents a certificate other than the one of the server")
+			}
+
+			return nil
+		},
+	}
+}
+
+// IsOwn reports whether cert is a certificate of the server, the current one or one it has rotated.
+func (r *Reloader) IsOwn(cert *x509.Certificate) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, own := range r.loaded {
+		if bytes.Equal(own.Raw, cert.Raw) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Watch checks the files every interval and reloads them when they change. A failed reload keeps
+// the certificates loaded before, rotation tools write the files one after another.
+func (r *Reloader) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		changed, err := r.changed()
+		if err != nil {
+			r.logger.Warn("cannot check certificates", zap.Error(err))
+			continue
+		}
+
+		if !changed {
+			continue
+		}
+
+		if err = r.reload(); err != nil {
+			r.logger.Error("cannot reload certificates, keeping the previous ones", zap.Error(err))
+			continue
+		}
+
+		r.logger.Info("certificates reloaded", zap.Time("notAfter", r.current().Leaf.NotAfter))
+	}
+}
+
+func (r *Reloader) files() []string {
+	files := []string{r.certFile, r.keyFile}
+	if r.MutualTLS() {
+		files = append(files, r.clientCAFile)
+	}
+
+	return files
+}
+
+func (r *Reloader) modificationTimes() ([]time.Time, error) {
+	files := r.files()
+	modTimes := make([]time.Time, 0, len(files))
+
+	for _, file := range files {
+		info, err := os.Stat(file)
+		if err != nil {
+			return nil, fmt.Errorf("cannot stat %s | %w", file, err)
+		}
+
+		modTimes = append(modTimes, info.ModTime())
+	}
+
+	return modTimes, nil
+}
+
+func (r *Reloader) changed() (bool, error) {
+	modTimes, err := r.modificationTimes()
+	if err != nil {
+		return false, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for i, modTime := range modTimes {
+		if !modTime.Equal(r.modTimes[i]) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (r *Reloader) reload() error {
+	// taken before reading, a file written in between is read again on the next check
+	modTimes, err := r.modificationTimes()
+	if err != nil {
+		return err
+	}
+
+	certificate, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return fmt.Errorf("cannot load certificate | %w", err)
+	}
+
+	var clientCAs *x509.CertPool
+
+	if r.MutualTLS() {
+		raw, readErr := os.ReadFile(r.clientCAFile)
+		if readErr != nil {
+			return fmt.Errorf("cannot read client ca bundle | %w", readErr)
+		}
+
+		clientCAs = x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(raw) {
+			return ErrNoClientCAs
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.certificate = &certificate
+	r.clientCAs = clientCAs
+
+	now := time.Now()
+	r.loaded = slices.DeleteFunc(r.loaded, func(cert *x509.Certificate) bool {
+		return now.After(cert.NotAfter)
+	})
+	r.loaded = append(r.loaded, certificate.Leaf)
+	r.modTimes = modTimes
+
+	return nil
+}
+
+func (r *Reloader) current() *tls.Certificate {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.certificate
+}
+
+func (r *Reloader) getCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	return r.current(), nil
+}
+
+func (r *Reloader) verifyClient(state tls.ConnectionState) error {
+	if len(state.PeerCertificates) == 0 {
+		return ErrNoCertificate
+	}
+
+	leaf := state.PeerCertificates[0]
+
+	if r.IsOwn(leaf) {
+		return nil
+	}
+
+	r.mu.RLock()
+	clientCAs := r.clientCAs
+	r.mu.RUnlock()
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range state.PeerCertificates[1:] {
+		intermediates.AddCert(cert)
+	}
+
+	if _, err := leaf.Verify(x509.VerifyOptions{
+		Roots:         clientCAs,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}); err != nil {
+		return fmt.Errorf("cannot verify client certificate | %w", err)
+	}
+
+	return nil
+}
@@ -17,10 +17,54 @@ type Config struct {
 	HostName    string `envconfig:"EXAMPLE_SERVICE_HOST_NAME"`
 	ServiceName string `envconfig:"EXAMPLE_SERVICE_SERVICE_NAME"`
 
+	// ShutdownTimeout bounds the graceful stop of the servers, the requests still running after it are cut off.
+	ShutdownTimeout time.Duration `envconfig:"EXAMPLE_SERVICE_SHUTDOWN_TIMEOUT" default:"20s"`
+
+	HTTPServerConfig HTTPServerConfig
+	TLSConfig        TLSConfig
+	CORSConfig       CORSConfig
+
 	PostgresConfig PostgresConfig
 	MetricsConfig  MetricsConfig
 	TracingConfig  TracingConfig
 	TelegramConfig TelegramConfig
+	LeaseConfig    LeaseConfig
+	WatchConfig    WatchConfig
+	ProbeConfig    ProbeConfig
+}
+
+type HTTPServerConfig struct {
+	ReadHeaderTimeout time.Duration `envconfig:"EXAMPLE_SERVICE_HTTP_SERVER_READ_HEADER_TIMEOUT" default:"10s"`
+	ReadTimeout       time.Duration `envconfig:"EXAMPLE_SERVICE_HTTP_SERVER_READ_TIMEOUT" default:"30s"`
+	WriteTimeout      time.Duration `envconfig:"EXAMPLE_SERVICE_HTTP_SERVER_WRITE_TIMEOUT" default:"30s"`
+	IdleTimeout       time.Duration `envconfig:"EXAMPLE_SERVICE_HTTP_SERVER_IDLE_TIMEOUT" default:"2m"`
+	// GatewayMode is how the gateway calls gRPC, inprocess through memory or loopback over the gRPC port.
+	GatewayMode string `envconfig:"EXAMPLE_SERVICE_HTTP_SERVER_GATEWAY_MODE" default:"inprocess"`
+}
+
+type TLSConfig struct {
+	Enabled  bool   `envconfig:"EXAMPLE_SERVICE_TLS_ENABLED" default:"false"`
+	CertFile string `envconfig:"EXAMPLE_SERVICE_TLS_CERT_FILE"`
+	KeyFile  string `envconfig:"EXAMPLE_SERVICE_TLS_KEY_FILE"`
+	// ClientCAFile turns on mutual TLS, clients have to present a certificate signed by one of its CAs.
+	ClientCAFile string `envconfig:"EXAMPLE_SERVICE_TLS_CLIENT_CA_FILE"`
+	// ReloadInterval is how often the files are checked for rotated certificates.
+	ReloadInterval time.Duration `envconfig:"EXAMPLE_SERVICE_TLS_RELOAD_INTERVAL" default:"1m"`
+}
+
+// CORSConfig is the default policy of cross-origin requests to the gateway, AllowedOrigins may have exact
+// origins, patterns such as https://*.mattis.dev, or * for any origin unless credentials are allowed.
+type CORSConfig struct {
+	Enabled          bool          `envconfig:"EXAMPLE_SERVICE_CORS_ENABLED" default:"true"`
+	AllowedOrigins   []string      `envconfig:"EXAMPLE_SERVICE_CORS_ALLOWED_ORIGINS" default:"*"`
+	AllowCredentials bool          `envconfig:"EXAMPLE_SERVICE_CORS_ALLOW_CREDENTIALS" default:"false"`
+	AllowedMethods   []string      `envconfig:"EXAMPLE_SERVICE_CORS_ALLOWED_METHODS" default:"GET,POST,PUT,PATCH,DELETE"`
+	AllowedHeaders   []string      `envconfig:"EXAMPLE_SERVICE_CORS_ALLOWED_HEADERS" default:"Content-Type,Authorization,Accept-Language,If-Match,X-Actor,X-Grpc-Web,X-User-Agent,Grpc-Timeout,Connect-Protocol-Version,Connect-Timeout-Ms"`
+	ExposedHeaders   []string      `envconfig:"EXAMPLE_SERVICE_CORS_EXPOSED_HEADERS" default:"ETag,Deprecation,Link,Content-Language,Grpc-Status,Grpc-Message,Grpc-Status-Details-Bin"`
+	MaxAge           time.Duration `envconfig:"EXAMPLE_SERVICE_CORS_MAX_AGE" default:"1h"`
+	// Routes override the policy for path prefixes, a JSON object such as
+	// {"/v1/services:watch": {"allowedOrigins": ["https://dashboard.mattis.dev"], "maxAge": "10m"}}.
+	Routes string `envconfig:"EXAMPLE_SERVICE_CORS_ROUTES"`
 }
 
 type TelegramConfig struct {
@@ -30,6 +74,24 @@ type TelegramConfig struct {
 	AllowedChatIDs []int64       `envconfig:"EXAMPLE_SERVICE_TELEGRAM_ALLOWED_CHAT_IDS"`
 }
 
+type LeaseConfig struct {
+	ReaperInterval   time.Duration `envconfig:"EXAMPLE_SERVICE_LEASE_REAPER_INTERVAL" default:"10s"`
+	ExpiredRetention time.Duration `envconfig:"EXAMPLE_SERVICE_LEASE_EXPIRED_RETENTION" default:"10m"`
+}
+
+type WatchConfig struct {
+	ChangesPruneInterval time.Duration `envconfig:"EXAMPLE_SERVICE_WATCH_CHANGES_PRUNE_INTERVAL" default:"1h"`
+	ChangesRetention     time.Duration `envconfig:"EXAMPLE_SERVICE_WATCH_CHANGES_RETENTION" default:"24h"`
+}
+
+type ProbeConfig struct {
+	Interval           time.Duration `envconfig:"EXAMPLE_SERVICE_PROBE_INTERVAL" default:"30s"`
+	Timeout            time.Duration `envconfig:"EXAMPLE_SERVICE_PROBE_TIMEOUT" default:"5s"`
+	Concurrency        int           `envconfig:"EXAMPLE_SERVICE_PROBE_CONCURRENCY" default:"16"`
+	UnhealthyThreshold int           `envconfig:"EXAMPLE_SERVICE_PROBE_UNHEALTHY_THRESHOLD" default:"3"`
+	DegradedLatency    time.Duration `envconfig:"EXAMPLE_SERVICE_PROBE_DEGRADED_LATENCY" default:"1s"`
+}
+
 func FromEnv() (*Config, error) {
 	cfg := &Config{}
 
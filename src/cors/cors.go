@@ -0,0 +1,267 @@
+package cors
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// AnyOrigin allows every origin. It cannot be combined with credentials, browsers refuse the wildcard
+	// on credentialed responses and reflecting every origin would hand the cookies of users to any page.
+	AnyOrigin = "*"
+	// anyHeader allows whatever headers a preflight asks for.
+	anyHeader = "*"
+)
+
+var (
+	ErrNoOrigins            = errors.New("no allowed origins")
+	ErrAnyOriginCredentials = errors.New("the any origin wildcard cannot be combined with credentials")
+
+	ErrOriginNotAllowed  = errors.New("origin not allowed")
+	ErrMethodNotAllowed  = errors.New("method not allowed")
+	ErrHeadersNotAllowed = errors.New("headers not allowed")
+)
+
+// Policy is the CORS policy of a route. Unless it is AnyOrigin, an allowed origin is either exact,
+// such as https://registry.mattis.dev, or a pattern with * standing for a part of the host,
+// such as https://*.mattis.dev.
+type Policy struct {
+	AllowedOrigins   []string      `json:"allowedOrigins"`
+	AllowCredentials bool          `json:"allowCredentials"`
+	AllowedMethods   []string      `json:"allowedMethods"`
+	AllowedHeaders   []string      `json:"allowedHeaders"`
+	ExposedHeaders   []string      `json:"exposedHeaders"`
+	MaxAge           time.Duration `json:"-"`
+
+	anyOrigin bool
+	origins   map[string]bool
+	patterns  []*regexp.Regexp
+}
+
+// UnmarshalJSON decodes a policy of the routes configuration, maxAge is a duration such as "10m".
+// Fields the JSON does not have keep their values, a route override starts from the default policy.
+func (p *Policy) UnmarshalJSON(raw []byte) error {
+	type plain Policy
+
+	aux := struct {
+		*plain
+
+		MaxAge string `json:"maxAge"`
+	}{plain: (*plain)(p), MaxAge: ""}
+
+	if err := json.Unmarshal(raw, &aux); err != nil {
+		return err
+	}
+
+	if aux.MaxAge == "" {
+		return nil
+	}
+
+	maxAge, err := time.ParseDuration(aux.MaxAge)
+	if err != nil {
+		return fmt.Errorf("invalid maxAge | %w", err)
+	}
+
+	p.MaxAge = maxAge
+
+	return nil
+}
+
+func (p *Policy) compile() error {
+	if len(p.AllowedOrigins) == 0 {
+		return ErrNoOrigins
+	}
+
+	p.anyOrigin = false
+	p.origins = make(map[string]bool, len(p.AllowedOrigins))
+	p.patterns = nil
+
+	for _, origin := range p.AllowedOrigins {
+		origin = strings.ToLower(strings.TrimSpace(origin))
+
+		switch {
+		case origin == AnyOrigin:
+			p.anyOrigin = true
+		case strings.Contains(origin, "*"):
+			// a star stands for labels of the host, it never reaches past the scheme or the port
+			pattern := strings.ReplaceAll(regexp.QuoteMeta(origin), `\*`, `[^/:]+`)
+			p.patterns = append(p.patterns, regexp.MustCompile("^"+pattern+"$"))
+		default:
+			p.origins[origin] = true
+		}
+	}
+
+	if p.anyOrigin && p.AllowCredentials {
+		return ErrAnyOriginCredentials
+	}
+
+	for i, method := range p.AllowedMethods {
+		p.AllowedMethods[i] = strings.ToUpper(strings.TrimSpace(method))
+	}
+
+	return nil
+}
+
+// AllowsOrigin reports whether origin, the Origin header of a request, may read the responses of the route.
+func (p *Policy) AllowsOrigin(origin string) bool {
+	if p.anyOrigin {
+		return true
+	}
+
+	origin = strings.ToLower(origin)
+
+	if p.origins[origin] {
+		return true
+	}
+
+	for _, pattern := range p.patterns {
+		if pattern.MatchString(origin) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Preflight checks a preflight request of origin, asking for method and headers.
+func (p *Policy) Preflight(origin, method string, headers []string) error {
+	switch {
+	case !p.AllowsOrigin(origin):
+		return fmt.Errorf("%w: %s", ErrOriginNotAllowed, origin)
+	case !p.allowsMethod(method):
+		return fmt.Errorf("%w: %s", ErrMethodNotAllowed, method)
+	case !p.allowsHeaders(headers):
+		return fmt.Errorf("%w: %s", ErrHeadersNotAllowed, strings.Join(headers, ", "))
+	}
+
+	return nil
+}
+
+func (p *Policy) allowsMethod(method string) bool {
+	return slices.Contains(p.AllowedMethods, method)
+}
+
+func (p *Policy) allowsHeaders(headers []string) bool {
+	if slices.Contains(p.AllowedHeaders, anyHeader) {
+		return true
+	}
+
+	for _, header := range headers {
+		if !slices.ContainsFunc(p.AllowedHeaders, func(allowed string) bool {
+			return strings.EqualFold(allowed, header)
+		}) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// AllowOrigin is the Access-Control-Allow-Origin of a response to an allowed origin. The wildcard is kept
+// when any origin is allowed, such responses are the same for every origin and can be cached as one.
+func (p *Policy) AllowOrigin(origin string) string {
+	if p.anyOrigin {
+		return AnyOrigin
+	}
+
+	return origin
+}
+
+// MaxAgeSeconds is the Access-Control-Max-Age of a preflight response, empty when the policy has none.
+func (p *Policy) MaxAgeSeconds() string {
+	if p.MaxAge <= 0 {
+		return ""
+	}
+
+	return strconv.Itoa(int(p.MaxAge / time.Second))
+}
+
+// Policies are the default policy and its overrides for routes.
+type Policies struct {
+	base *Policy
+	// routes are ordered by their prefixes, the longest first
+	routes []route
+}
+
+type route struct {
+	prefix string
+	policy *Policy
+}
+
+// NewPolicies compiles the default policy and the route overrides. routes is a JSON object from
+// the path prefixes of the routes to their policies, such as {"/v1/services:watch": {"maxAge": "1m"}}.
+// An override has the fields of the default policy it does not set itself. Empty routes override nothing.
+func NewPolicies(base Policy, routes string) (*Policies, error) {
+	if err := base.compile(); err != nil {
+		return nil, fmt.Errorf("invalid cors policy | %w", err)
+	}
+
+	policies := &Policies{base: &base, routes: nil}
+
+	if strings.TrimSpace(routes) == "" {
+		return policies, nil
+	}
+
+	var overrides map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(routes), &overrides); err != nil {
+		return nil, fmt.Errorf("cannot parse cors routes | %w", err)
+	}
+
+	for prefix, raw := range overrides {
+		policy := base
+		policy.AllowedOrigins = slices.Clone(base.AllowedOrigins)
+		policy.AllowedMethods = slices.Clone(base.AllowedMethods)
+		policy.AllowedHeaders = slices.Clone(base.AllowedHeaders)
+		policy.ExposedHeaders = slices.Clone(base.ExposedHeaders)
+
+		if err := json.Unmarshal(raw, &policy); err != nil {
+			return nil, fmt.Errorf("cannot parse cors policy of %s | %w", prefix, err)
+		}
+
+		if err := policy.compile(); err != nil {
+			return nil, fmt.Errorf("invalid cors policy of %s | %w", prefix, err)
+		}
+
+		policies.routes = append(policies.routes, route{prefix: prefix, policy: &policy})
+	}
+
+	slices.SortFunc(policies.routes, func(a, b route) int {
+		return len(b.prefix) - len(a.prefix)
+	})
+
+	return policies, nil
+}
+
+// For returns the policy of the route of r, the override with the longest matching prefix or the default one.
+func (p *Policies) For(r *http.Request) *Policy {
+	for _, route := range p.routes {
+		if route.matches(r.URL.Path) {
+			return route.policy
+		}
+	}
+
+	return p.base
+}
+
+// matches reports whether path is under the prefix of the route. A prefix ends at a segment or at a custom
+// method, /v1/services matches /v1/services/a and /v1/services:watch but not /v1/servicesx.
+func (r route) matches(path string) bool {
+	if !strings.HasPrefix(path, r.prefix) {
+		return false
+	}
+
+	if len(path) == len(r.prefix) || strings.HasSuffix(r.prefix, "/") {
+		return true
+	}
+
+	next := path[len(r.prefix)]
+
+	return next == '/' || next == ':'
+}
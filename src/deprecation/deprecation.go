@@ -0,0 +1,40 @@
+package deprecation
+
+import (
+	"strconv"
+	"time"
+)
+
+const (
+	// Header marks a response of a deprecated method with the time it was deprecated, as in RFC 9745.
+	Header = "deprecation"
+	// LinkHeader points at the method that replaces a deprecated one.
+	LinkHeader = "link"
+)
+
+// Notice describes the deprecation of a method.
+type Notice struct {
+	Since time.Time
+	// Successor is the full name of the method to call instead, e.g. /package.Service/Method.
+	Successor string
+}
+
+// HeaderValue renders Since as the structured field date of the Deprecation header.
+func (n Notice) HeaderValue() string {
+	return "@" + strconv.FormatInt(n.Since.Unix(), 10)
+}
+
+// LinkValue renders the successor as a link relation. Full method names are paths, so they make valid references.
+func (n Notice) LinkValue() string {
+	return "<" + n.Successor + `>; rel="successor-version"`
+}
+
+// Notices holds the deprecated methods by their full names.
+type Notices map[string]Notice
+
+// Lookup returns the notice of a method, if it is deprecated.
+func (n Notices) Lookup(fullMethod string) (Notice, bool) {
+	notice, ok := n[fullMethod]
+
+	return notice, ok
+}
@@ -0,0 +1,359 @@
+// Package discovery resolves registry:///<service-name> gRPC targets to the gRPC endpoints of the instances
+// of a registered service and keeps client connections up to date by watching the registry.
+// Every instance becomes an endpoint of the connection, so balancers spread calls across instances.
+// Instances register themselves with RegisterInstance and keep their lease with InstanceHeartbeat.
+//
+// Usage:
+//
+//	registryConn, err := grpc.NewClient("registry.internal:8080", opts...)
+//	...
+//	conn, err := grpc.NewClient(
+//		"registry:///billing",
+//		grpc.WithResolvers(discovery.NewBuilder(registryConn, discovery.WithBalancer(discovery.BalancerRoundRobin))),
+//		opts...,
+//	)
+//
+// Register installs the builder globally instead, so that grpc.NewClient resolves the scheme without options.
+package discovery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/serviceconfig"
+	"google.golang.org/grpc/status"
+
+	servergrpc "github.com/ingvarmattis/example/gen/servergrpc/example"
+)
+
+// Scheme is the target scheme handled by the builder.
+const Scheme = "registry"
+
+const (
+	minRetryDelay = 100 * time.Millisecond
+	maxRetryDelay = 30 * time.Second
+)
+
+var (
+	ErrEmptyServiceName = errors.New("service name is empty")
+	ErrNotRegistered    = errors.New("service is not registered")
+	ErrNoGRPCEndpoints  = errors.New("service has no instances with grpc endpoints")
+	ErrUnknownBalancer  = errors.New("unknown balancer")
+)
+
+// Balancer is the load balancing policy of connections built by the resolver.
+type Balancer string
+
+const (
+	BalancerPickFirst  Balancer = "pick_first"
+	BalancerRoundRobin Balancer = "round_robin"
+)
+
+type Option func(*Builder)
+
+// WithBalancer sets the load balancing policy, BalancerPickFirst is used by default.
+func WithBalancer(balancer Balancer) Option {
+	return func(b *Builder) {
+		b.balancer = balancer
+	}
+}
+
+// Builder builds resolvers for registry:///<service-name> targets.
+type Builder struct {
+	client   servergrpc.ExampleServiceClient
+	balancer Balancer
+}
+
+// NewBuilder returns a builder that reads the registry through registryConn.
+func NewBuilder(registryConn grpc.ClientConnInterface, opts ...Option) *Builder {
+	builder := &Builder{
+		client:   servergrpc.NewExampleServiceClient(registryConn),
+		balancer: BalancerPickFirst,
+	}
+
+	for _, opt := range opts {
+		opt(builder)
+	}
+
+	return builder
+}
+
+// Register installs a builder for Scheme globally. Like resolver.Register, it must be called during initialization.
+func Register(registryConn grpc.ClientConnInterface, opts ...Option) {
+	resolver.Register(NewBuilder(registryConn, opts...))
+}
+
+func (b *Builder) Scheme() string {
+	return Scheme
+}
+
+func (b *Builder) Build(
+	target resolver.Target, cc resolver.ClientConn, _ resolver.BuildOptions,
+) (resolver.Resolver, error) {
+	serviceName := target.Endpoint()
+	if serviceName == "" {
+		return nil, ErrEmptyServiceName
+	}
+
+	switch b.balancer {
+	case BalancerPickFirst, BalancerRoundRobin:
+	default:
+		return nil, fmt.Errorf("%w %q", ErrUnknownBalancer, b.balancer)
+	}
+
+	serviceConfig := cc.ParseServiceConfig(fmt.Sprintf(`{"loadBalancingConfig":[{%q:{}}]}`, b.balancer))
+	if serviceConfig.Err != nil {
+		return nil, fmt.Errorf("cannot parse service config | %w", serviceConfig.Err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	r := &registryResolver{
+		client:        b.client,
+		serviceName:   serviceName,
+		cc:            cc,
+		serviceConfig: serviceConfig,
+		resolveNow:    make(chan struct{}, 1),
+		resolved:      false,
+		registered:    false,
+		instances:     make(map[string][]resolver.Address),
+		cancel:        cancel,
+		done:          make(chan struct{}),
+	}
+
+	go r.run(ctx)
+
+	return r, nil
+}
+
+// registryResolver follows a single service. It looks the service and its instances up once its watch
+// is in place and then applies every change from the watch, so no update can fall in between.
+type registryResolver struct {
+	client        servergrpc.ExampleServiceClient
+	serviceName   string
+	cc            resolver.ClientConn
+	serviceConfig *serviceconfig.ParseResult
+
+	resolveNow chan struct{}
+	// resolved, registered and instances are only touched by the run goroutine.
+	resolved   bool
+	registered bool
+	// instances are the grpc addresses of every instance by its id
+	instances map[string][]resolver.Address
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// ResolveNow looks the service up again, or reconnects right away when the registry is unreachable.
+func (r *registryResolver) ResolveNow(resolver.ResolveNowOptions) {
+	select {
+	case r.resolveNow <- struct{}{}:
+	default:
+	}
+}
+
+func (r *registryResolver) Close() {
+	r.cancel()
+	<-r.done
+}
+
+func (r *registryResolver) run(ctx context.Context) {
+	defer close(r.done)
+
+	delay := minRetryDelay
+
+	for {
+		lookedUp, err := r.watch(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+
+		// addresses resolved before stay in use while the registry is unreachable
+		if !r.resolved {
+			r.cc.ReportError(err)
+		}
+
+		// a watch that got as far as a lookup had a working registry, its break starts the backoff over
+		if lookedUp {
+			delay = minRetryDelay
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-r.resolveNow:
+		case <-time.After(delay):
+		}
+
+		delay = min(delay*2, maxRetryDelay)
+	}
+}
+
+// watch follows the service until the stream breaks, it reports whether the service has been looked up.
+func (r *registryResolver) watch(ctx context.Context) (bool, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := r.client.WatchServices(ctx, &servergrpc.WatchServicesRequest{
+		FromRevision: 0,
+		ServiceName:  r.serviceName,
+	})
+	if err != nil {
+		return false, fmt.Errorf("cannot watch services | %w", err)
+	}
+
+	// the registry sends headers once the watch is subscribed
+	if _, err = stream.Header(); err != nil {
+		return false, fmt.Errorf("cannot watch services | %w", err)
+	}
+
+	if err = r.lookup(ctx); err != nil {
+		return false, err
+	}
+
+	events := make(chan *servergrpc.WatchServicesResponse)
+	recvErr := make(chan error, 1)
+
+	go func() {
+		for {
+			event, streamErr := stream.Recv()
+			if streamErr != nil {
+				recvErr <- streamErr
+				return
+			}
+
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return true, ctx.Err()
+		case err = <-recvErr:
+			return true, fmt.Errorf("watch stream closed | %w", err)
+		case <-r.resolveNow:
+			if err = r.lookup(ctx); err != nil {
+				return true, err
+			}
+		case event := <-events:
+			r.apply(event)
+
+			if err = r.update(); err != nil {
+				return true, err
+			}
+		}
+	}
+}
+
+// lookup reads the service and all of its instances.
+func (r *registryResolver) lookup(ctx context.Context) error {
+	resp, err := r.client.GetService(ctx, &servergrpc.GetServiceRequest{ServiceName: r.serviceName})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			r.forget()
+			return r.update()
+		}
+
+		return fmt.Errorf("cannot get service | %w", err)
+	}
+
+	instances, err := r.client.ListInstances(ctx, &servergrpc.ListInstancesRequest{ServiceName: r.serviceName})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			r.forget()
+			return r.update()
+		}
+
+		return fmt.Errorf("cannot list instances | %w", err)
+	}
+
+	r.registered = resp.GetService().GetExpiredAt() == nil
+
+	clear(r.instances)
+
+	for _, instance := range instances.GetInstances() {
+		r.instances[instance.GetInstanceId()] = grpcAddresses(instance.GetEndpoints())
+	}
+
+	return r.update()
+}
+
+// apply changes the known state of the service by an event of the watch.
+func (r *registryResolver) apply(event *servergrpc.WatchServicesResponse) {
+	switch event.GetType() {
+	case servergrpc.ServiceEventType_SERVICE_EVENT_TYPE_REMOVED:
+		r.forget()
+	case servergrpc.ServiceEventType_SERVICE_EVENT_TYPE_INSTANCE_ADDED:
+		instance := event.GetInstance()
+		r.instances[instance.GetInstanceId()] = grpcAddresses(instance.GetEndpoints())
+	case servergrpc.ServiceEventType_SERVICE_EVENT_TYPE_INSTANCE_REMOVED:
+		delete(r.instances, event.GetInstance().GetInstanceId())
+	case servergrpc.ServiceEventType_SERVICE_EVENT_TYPE_ADDED,
+		servergrpc.ServiceEventType_SERVICE_EVENT_TYPE_UPDATED,
+		servergrpc.ServiceEventType_SERVICE_EVENT_TYPE_UNSPECIFIED:
+		r.registered = event.GetService().GetExpiredAt() == nil
+	}
+}
+
+// forget drops the service along with its instances, deleting a service deletes its instances too.
+func (r *registryResolver) forget() {
+	r.registered = false
+	clear(r.instances)
+}
+
+// update pushes an endpoint for every instance with grpc endpoints to the client connection,
+// or reports why there is none.
+func (r *registryResolver) update() error {
+	r.resolved = true
+
+	if !r.registered {
+		r.cc.ReportError(fmt.Errorf("%w: %s", ErrNotRegistered, r.serviceName))
+		return nil
+	}
+
+	endpoints := make([]resolver.Endpoint, 0, len(r.instances))
+	for _, instanceID := range slices.Sorted(maps.Keys(r.instances)) {
+		if addresses := r.instances[instanceID]; len(addresses) > 0 {
+			endpoints = append(endpoints, resolver.Endpoint{Addresses: addresses})
+		}
+	}
+
+	if len(endpoints) == 0 {
+		r.cc.ReportError(fmt.Errorf("%w: %s", ErrNoGRPCEndpoints, r.serviceName))
+		return nil
+	}
+
+	if err := r.cc.UpdateState(resolver.State{
+		Endpoints:     endpoints,
+		ServiceConfig: r.serviceConfig,
+	}); err != nil {
+		return fmt.Errorf("cannot update client connection state | %w", err)
+	}
+
+	return nil
+}
+
+func grpcAddresses(endpoints []*servergrpc.Endpoint) []resolver.Address {
+	var addresses []resolver.Address
+
+	for _, endpoint := range endpoints {
+		if endpoint.GetProtocol() == servergrpc.EndpointProtocol_ENDPOINT_PROTOCOL_GRPC {
+			addresses = append(addresses, resolver.Address{Addr: endpoint.GetAddress()})
+		}
+	}
+
+	return addresses
+}
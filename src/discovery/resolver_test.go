@@ -0,0 +1,354 @@
+package discovery
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/url"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/serviceconfig"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	servergrpc "github.com/ingvarmattis/example/gen/servergrpc/example"
+)
+
+const (
+	testServiceName = "billing"
+	// resolveTimeout is well below the retry delay a resolver reaches after a few reconnects without a reset
+	resolveTimeout = time.Second
+)
+
+// fakeRegistry serves the calls the resolver makes from memory. Changes made through its methods
+// are sent to the running watches, breakWatches ends them as a restarting registry would.
+type fakeRegistry struct {
+	servergrpc.UnimplementedExampleServiceServer
+
+	mu         sync.Mutex
+	registered bool
+	instances  map[string][]string
+	watches    map[chan *servergrpc.WatchServicesResponse]struct{}
+}
+
+func (f *fakeRegistry) GetService(
+	_ context.Context, req *servergrpc.GetServiceRequest,
+) (*servergrpc.GetServiceResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if !f.registered {
+		return nil, status.Error(codes.NotFound, "service is not registered")
+	}
+
+	return &servergrpc.GetServiceResponse{Service: &servergrpc.Service{ServiceName: req.GetServiceName()}}, nil
+}
+
+func (f *fakeRegistry) ListInstances(
+	_ context.Context, req *servergrpc.ListInstancesRequest,
+) (*servergrpc.ListInstancesResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if !f.registered {
+		return nil, status.Error(codes.NotFound, "service is not registered")
+	}
+
+	resp := &servergrpc.ListInstancesResponse{}
+	for instanceID, addresses := range f.instances {
+		resp.Instances = append(resp.Instances, instance(req.GetServiceName(), instanceID, addresses))
+	}
+
+	return resp, nil
+}
+
+func (f *fakeRegistry) WatchServices(
+	_ *servergrpc.WatchServicesRequest, stream servergrpc.ExampleService_WatchServicesServer,
+) error {
+	events := make(chan *servergrpc.WatchServicesResponse, 16)
+
+	f.mu.Lock()
+	f.watches[events] = struct{}{}
+	f.mu.Unlock()
+
+	if err := stream.SendHeader(metadata.Pairs("x-registry-revision", "0")); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case event, ok := <-events:
+			if !ok {
+				return status.Error(codes.Unavailable, "registry is restarting")
+			}
+
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (f *fakeRegistry) setInstance(instanceID string, addresses ...string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.instances[instanceID] = addresses
+	f.broadcast(&servergrpc.WatchServicesResponse{
+		Type:     servergrpc.ServiceEventType_SERVICE_EVENT_TYPE_INSTANCE_ADDED,
+		Service:  &servergrpc.Service{ServiceName: testServiceName},
+		Instance: instance(testServiceName, instanceID, addresses),
+	})
+}
+
+func (f *fakeRegistry) removeInstance(instanceID string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	addresses := f.instances[instanceID]
+	delete(f.instances, instanceID)
+	f.broadcast(&servergrpc.WatchServicesResponse{
+		Type:     servergrpc.ServiceEventType_SERVICE_EVENT_TYPE_INSTANCE_REMOVED,
+		Service:  &servergrpc.Service{ServiceName: testServiceName},
+		Instance: instance(testServiceName, instanceID, addresses),
+	})
+}
+
+func (f *fakeRegistry) unregister() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.registered = false
+	clear(f.instances)
+	f.broadcast(&servergrpc.WatchServicesResponse{
+		Type:    servergrpc.ServiceEventType_SERVICE_EVENT_TYPE_REMOVED,
+		Service: &servergrpc.Service{ServiceName: testServiceName},
+	})
+}
+
+// breakWatches ends every running watch and, while no watch is running, moves instanceID to address.
+func (f *fakeRegistry) breakWatches(instanceID, address string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for events := range f.watches {
+		delete(f.watches, events)
+		close(events)
+	}
+
+	f.instances[instanceID] = []string{address}
+}
+
+func (f *fakeRegistry) broadcast(event *servergrpc.WatchServicesResponse) {
+	for events := range f.watches {
+		events <- event
+	}
+}
+
+func instance(serviceName, instanceID string, addresses []string) *servergrpc.Instance {
+	endpoints := make([]*servergrpc.Endpoint, 0, len(addresses)+1)
+	for _, address := range addresses {
+		endpoints = append(endpoints, &servergrpc.Endpoint{
+			Protocol: servergrpc.EndpointProtocol_ENDPOINT_PROTOCOL_GRPC,
+			Address:  address,
+		})
+	}
+
+	// endpoints of other protocols are not resolved
+	endpoints = append(endpoints, &servergrpc.Endpoint{
+		Protocol: servergrpc.EndpointProtocol_ENDPOINT_PROTOCOL_HTTP,
+		Address:  "10.0.0.100:8080",
+	})
+
+	return &servergrpc.Instance{ServiceName: serviceName, InstanceId: instanceID, Endpoints: endpoints}
+}
+
+// fakeClientConn records what the resolver reports.
+type fakeClientConn struct {
+	resolver.ClientConn
+
+	states chan resolver.State
+	errs   chan error
+}
+
+func (c *fakeClientConn) UpdateState(state resolver.State) error {
+	c.states <- state
+	return nil
+}
+
+func (c *fakeClientConn) ReportError(err error) {
+	c.errs <- err
+}
+
+func (c *fakeClientConn) ParseServiceConfig(string) *serviceconfig.ParseResult {
+	return &serviceconfig.ParseResult{}
+}
+
+// waitEndpoints waits for a state with an endpoint per instance holding the addresses of want.
+func (c *fakeClientConn) waitEndpoints(t *testing.T, want ...[]string) {
+	t.Helper()
+
+	timeout := time.After(resolveTimeout)
+
+	var last [][]string
+
+	for {
+		select {
+		case state := <-c.states:
+			last = endpointAddresses(state)
+			if slices.EqualFunc(last, want, slices.Equal[[]string]) {
+				return
+			}
+		case <-c.errs:
+		case <-timeout:
+			t.Fatalf("endpoints %v, want %v", last, want)
+		}
+	}
+}
+
+func (c *fakeClientConn) waitError(t *testing.T, want error) {
+	t.Helper()
+
+	timeout := time.After(resolveTimeout)
+
+	for {
+		select {
+		case <-c.states:
+		case err := <-c.errs:
+			if errors.Is(err, want) {
+				return
+			}
+		case <-timeout:
+			t.Fatalf("no %v reported", want)
+		}
+	}
+}
+
+func endpointAddresses(state resolver.State) [][]string {
+	endpoints := make([][]string, 0, len(state.Endpoints))
+	for _, endpoint := range state.Endpoints {
+		addresses := make([]string, 0, len(endpoint.Addresses))
+		for _, address := range endpoint.Addresses {
+			addresses = append(addresses, address.Addr)
+		}
+
+		endpoints = append(endpoints, addresses)
+	}
+
+	return endpoints
+}
+
+// resolve serves registry over bufconn and builds a resolver of testServiceName on top of it.
+func resolve(t *testing.T, registry *fakeRegistry) *fakeClientConn {
+	t.Helper()
+
+	listener := bufconn.Listen(1 << 20)
+
+	server := grpc.NewServer()
+	servergrpc.RegisterExampleServiceServer(server, registry)
+
+	go func() { _ = server.Serve(listener) }()
+
+	t.Cleanup(server.Stop)
+
+	registryConn, err := grpc.NewClient("passthrough:///registry",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() { _ = registryConn.Close() })
+
+	cc := &fakeClientConn{
+		states: make(chan resolver.State, 64),
+		errs:   make(chan error, 64),
+	}
+
+	r, err := NewBuilder(registryConn, WithBalancer(BalancerRoundRobin)).Build(
+		resolver.Target{URL: url.URL{Scheme: Scheme, Path: "/" + testServiceName}}, cc, resolver.BuildOptions{},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(r.Close)
+
+	return cc
+}
+
+func newFakeRegistry(instances map[string][]string) *fakeRegistry {
+	return &fakeRegistry{
+		registered: true,
+		instances:  instances,
+		watches:    make(map[chan *servergrpc.WatchServicesResponse]struct{}),
+	}
+}
+
+func TestResolverFollowsInstances(t *testing.T) {
+	registry := newFakeRegistry(map[string][]string{
+		"billing-1": {"10.0.0.1:9000"},
+		"billing-2": {"10.0.0.2:9000"},
+	})
+
+	cc := resolve(t, registry)
+
+	// initial resolve, an endpoint for every instance in the order of their ids
+	cc.waitEndpoints(t, []string{"10.0.0.1:9000"}, []string{"10.0.0.2:9000"})
+
+	// an instance registers again with other endpoints
+	registry.setInstance("billing-2", "10.0.0.2:9001", "10.0.0.3:9001")
+	cc.waitEndpoints(t, []string{"10.0.0.1:9000"}, []string{"10.0.0.2:9001", "10.0.0.3:9001"})
+
+	// a new instance comes up
+	registry.setInstance("billing-0", "10.0.0.4:9000")
+	cc.waitEndpoints(t,
+		[]string{"10.0.0.4:9000"}, []string{"10.0.0.1:9000"}, []string{"10.0.0.2:9001", "10.0.0.3:9001"},
+	)
+
+	// an instance is unregistered or misses its lease
+	registry.removeInstance("billing-1")
+	cc.waitEndpoints(t, []string{"10.0.0.4:9000"}, []string{"10.0.0.2:9001", "10.0.0.3:9001"})
+
+	registry.removeInstance("billing-0")
+	registry.removeInstance("billing-2")
+	cc.waitError(t, ErrNoGRPCEndpoints)
+
+	// the service is gone
+	registry.unregister()
+	cc.waitError(t, ErrNotRegistered)
+}
+
+func TestResolverReconnects(t *testing.T) {
+	registry := newFakeRegistry(map[string][]string{
+		"billing-1": {"10.0.0.1:9000"},
+	})
+
+	cc := resolve(t, registry)
+
+	cc.waitEndpoints(t, []string{"10.0.0.1:9000"})
+
+	// every reconnect looks the service up again, and the retry delay does not grow
+	// across reconnects after successful watches
+	for _, address := range []string{"10.0.1.1:9000", "10.0.2.1:9000", "10.0.3.1:9000", "10.0.4.1:9000", "10.0.5.1:9000"} {
+		registry.breakWatches("billing-1", address)
+		cc.waitEndpoints(t, []string{address})
+	}
+
+	// the watch is running again
+	registry.setInstance("billing-2", "10.0.0.2:9000")
+	cc.waitEndpoints(t, []string{"10.0.5.1:9000"}, []string{"10.0.0.2:9000"})
+}
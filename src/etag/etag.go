@@ -0,0 +1,43 @@
+package etag
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+const (
+	// Header is the response header carrying the resource version of the returned service.
+	Header = "etag"
+	// IfMatchHeader is the request header with the resource version an update or a deletion expects.
+	IfMatchHeader = "if-match"
+)
+
+var ErrInvalidIfMatch = errors.New("invalid if-match header")
+
+// Format renders a resource version as a strong entity tag.
+func Format(resourceVersion int64) string {
+	return strconv.Quote(strconv.FormatInt(resourceVersion, 10))
+}
+
+// ParseIfMatch returns the resource version an If-Match header expects. The wildcard expects nothing
+// and yields zero. Weak tags and lists are rejected, a version either matches exactly or not at all.
+func ParseIfMatch(value string) (int64, error) {
+	value = strings.TrimSpace(value)
+
+	if value == "*" {
+		return 0, nil
+	}
+
+	unquoted, err := strconv.Unquote(value)
+	if err != nil || !strings.HasPrefix(value, `"`) {
+		return 0, ErrInvalidIfMatch
+	}
+
+	resourceVersion, err := strconv.ParseInt(unquoted, 10, 64)
+	if err != nil || resourceVersion <= 0 {
+		return 0, ErrInvalidIfMatch
+	}
+
+	return resourceVersion, nil
+}
@@ -0,0 +1,293 @@
+package i18n
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"io/fs"
+	"maps"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/go-playground/locales"
+	"github.com/go-playground/locales/de"
+	"github.com/go-playground/locales/en"
+	"github.com/go-playground/locales/ru"
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	deTranslations "github.com/go-playground/validator/v10/translations/de"
+	enTranslations "github.com/go-playground/validator/v10/translations/en"
+	ruTranslations "github.com/go-playground/validator/v10/translations/ru"
+	"golang.org/x/text/language"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"gopkg.in/yaml.v3"
+
+	"github.com/ingvarmattis/example/gen/reasons"
+)
+
+const (
+	// Header is the request metadata with the languages the caller prefers, in the syntax of Accept-Language.
+	Header = "accept-language"
+	// gatewayHeader is the name the gateway forwards the Accept-Language HTTP header with.
+	gatewayHeader = "grpcgateway-" + Header
+
+	// Fallback is the language of the messages a bundle lacks, and of the callers that prefer none of the bundles.
+	Fallback = "en"
+)
+
+//go:embed bundles/*.yaml
+var bundleFiles embed.FS
+
+// supported are the languages a bundle may be written in, those the validator has default translations for.
+var supported = map[string]struct {
+	locale       func() locales.Translator
+	translations func(*validator.Validate, ut.Translator) error
+}{
+	"en": {locale: en.New, translations: enTranslations.RegisterDefaultTranslations},
+	"de": {locale: de.New, translations: deTranslations.RegisterDefaultTranslations},
+	"ru": {locale: ru.New, translations: ruTranslations.RegisterDefaultTranslations},
+}
+
+// bundle is a message bundle file, see bundles/en.yaml.
+type bundle struct {
+	Locale     string                    `yaml:"locale"`
+	Reasons    map[reasons.Reason]string `yaml:"reasons"`
+	Validation map[string]string         `yaml:"validation"`
+}
+
+// Bundles are the messages of every language the service speaks, loaded from the bundles directory.
+type Bundles struct {
+	universal *ut.UniversalTranslator
+	matcher   language.Matcher
+	// locales are in the order of the tags of matcher, Fallback first
+	locales  []string
+	messages map[string]map[reasons.Reason]string
+}
+
+// NewBundles loads the message bundles and registers their translations of the validation errors with validate.
+func NewBundles(validate *validator.Validate) (*Bundles, error) {
+	loaded, err := loadBundles(bundleFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	fallback := loaded[Fallback]
+
+	english := en.New()
+	universal := ut.New(english, english)
+
+	b := &Bundles{
+		universal: universal,
+		matcher:   nil,
+		locales:   []string{Fallback},
+		messages:  make(map[string]map[reasons.Reason]string, len(loaded)),
+	}
+
+	for _, locale := range slices.Sorted(maps.Keys(loaded)) {
+		if locale != Fallback {
+			b.locales = append(b.locales, locale)
+		}
+	}
+
+	tags := make([]language.Tag, 0, len(b.locales))
+
+	for _, locale := range b.locales {
+		current := loaded[locale]
+
+		if locale != Fallback {
+			if err = universal.AddTranslator(supported[locale].locale(), true); err != nil {
+				return nil, fmt.Errorf("cannot add translator of %s | %w", locale, err)
+			}
+		}
+
+		translator, _ := universal.GetTranslator(locale)
+
+		if err = supported[locale].translations(validate, translator); err != nil {
+			return nil, fmt.Errorf("error while register default translations of %s | %w", locale, err)
+		}
+
+		// tags a bundle does not describe keep the English description
+		for tag, text := range fallback.Validation {
+			if translated, ok := current.Validation[tag]; ok {
+				text = translated
+			}
+
+			if err = registerTranslation(validate, translator, tag, text); err != nil {
+				return nil, fmt.Errorf("error while register translation `%s` of %s | %w", tag, locale, err)
+			}
+		}
+
+		b.messages[locale] = current.Reasons
+		tags = append(tags, language.Make(locale))
+	}
+
+	b.matcher = language.NewMatcher(tags)
+
+	return b, nil
+}
+
+func MustBundles(validate *validator.Validate) *Bundles {
+	bundles, err := NewBundles(validate)
+	if err != nil {
+		panic(err)
+	}
+
+	return bundles
+}
+
+func loadBundles(files fs.FS) (map[string]*bundle, error) {
+	names, err := fs.Glob(files, "bundles/*.yaml")
+	if err != nil {
+		return nil, fmt.Errorf("cannot list message bundles | %w", err)
+	}
+
+	loaded := make(map[string]*bundle, len(names))
+
+	for _, name := range names {
+		raw, readErr := fs.ReadFile(files, name)
+		if readErr != nil {
+			return nil, fmt.Errorf("cannot read message bundle %s | %w", name, readErr)
+		}
+
+		var current bundle
+		if err = yaml.Unmarshal(raw, &current); err != nil {
+			return nil, fmt.Errorf("cannot parse message bundle %s | %w", name, err)
+		}
+
+		if err = current.check(strings.TrimSuffix(path.Base(name), ".yaml")); err != nil {
+			return nil, fmt.Errorf("invalid message bundle %s | %w", name, err)
+		}
+
+		loaded[current.Locale] = &current
+	}
+
+	fallback, ok := loaded[Fallback]
+	if !ok {
+		return nil, fmt.Errorf("no message bundle of the fallback language %s", Fallback)
+	}
+
+	for _, reason := range reasons.All() {
+		if fallback.Reasons[reason] == "" {
+			return nil, fmt.Errorf("message bundle %s lacks the message of %s", Fallback, reason)
+		}
+	}
+
+	return loaded, nil
+}
+
+func (b *bundle) check(fileLocale string) error {
+	if b.Locale != fileLocale {
+		return fmt.Errorf("locale %q differs from the name of the file", b.Locale)
+	}
+
+	if _, ok := supported[b.Locale]; !ok {
+		return fmt.Errorf("locale %q is not supported", b.Locale)
+	}
+
+	for reason := range b.Reasons {
+		if !reason.Known() {
+			return fmt.Errorf("reason %s is not in the catalog", reason)
+		}
+	}
+
+	return nil
+}
+
+func registerTranslation(validate *validator.Validate, translator ut.Translator, tag, text string) error {
+	return validate.RegisterTranslation(tag, translator,
+		func(translator ut.Translator) error {
+			return translator.Add(tag, text, true)
+		},
+		func(translator ut.Translator, fe validator.FieldError) string {
+			translated, err := translator.T(fe.Tag(), fe.Field())
+			if err != nil {
+				return fe.Error()
+			}
+
+			return translated
+		},
+	)
+}
+
+// Locale is the language of the bundles that suits the caller best, taken from the accept-language metadata
+// or the Accept-Language header the gateway forwards. Fallback when the caller prefers none of them.
+func (b *Bundles) Locale(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	accepted := md.Get(Header)
+	if len(accepted) == 0 {
+		accepted = md.Get(gatewayHeader)
+	}
+
+	if len(accepted) == 0 {
+		return Fallback
+	}
+
+	tags, _, err := language.ParseAcceptLanguage(strings.Join(accepted, ","))
+	if err != nil || len(tags) == 0 {
+		return Fallback
+	}
+
+	_, index, confidence := b.matcher.Match(tags...)
+	if confidence == language.No {
+		return Fallback
+	}
+
+	return b.locales[index]
+}
+
+// Translator describes validation errors in the language of locale, in the Fallback one if there is no such bundle.
+func (b *Bundles) Translator(locale string) ut.Translator {
+	translator, _ := b.universal.GetTranslator(locale)
+
+	return translator
+}
+
+// Message returns the message of reason in locale, or in Fallback if the bundle of locale lacks it.
+// The locale returned is the one of the message.
+func (b *Bundles) Message(locale string, reason reasons.Reason) (string, string, bool) {
+	if message, ok := b.messages[locale][reason]; ok && message != "" {
+		return message, locale, true
+	}
+
+	if message, ok := b.messages[Fallback][reason]; ok && message != "" {
+		return message, Fallback, true
+	}
+
+	return "", "", false
+}
+
+// Localize adds a LocalizedMessage in the language of the caller to a status with the ErrorInfo of a known
+// reason. Other errors, and statuses that already have a LocalizedMessage, are returned as they are.
+func (b *Bundles) Localize(ctx context.Context, err error) error {
+	st, ok := status.FromError(err)
+	if err == nil || !ok {
+		return err
+	}
+
+	var reason reasons.Reason
+
+	for _, detail := range st.Details() {
+		switch detail := detail.(type) {
+		case *errdetails.LocalizedMessage:
+			return err
+		case *errdetails.ErrorInfo:
+			reason = reasons.Reason(detail.GetReason())
+		}
+	}
+
+	message, locale, found := b.Message(b.Locale(ctx), reason)
+	if !found {
+		return err
+	}
+
+	localized, detailsErr := st.WithDetails(&errdetails.LocalizedMessage{Locale: locale, Message: message})
+	if detailsErr != nil {
+		return err
+	}
+
+	return localized.Err()
+}
@@ -0,0 +1,71 @@
+package identity
+
+import (
+	"context"
+	"crypto/x509"
+)
+
+const (
+	// ForwardedCertHeader carries the client certificate of a request the gateway proxies, in DER.
+	// It is only trusted on calls of the gateway itself.
+	ForwardedCertHeader = "x-forwarded-client-cert-bin"
+
+	spiffeScheme = "spiffe"
+)
+
+// Identity is who a client certificate names. Handlers get it with FromContext.
+type Identity struct {
+	// SPIFFEID is the spiffe:// URI SAN of the certificate, empty when it has none.
+	SPIFFEID string
+	URIs     []string
+	DNSNames []string
+	// CommonName of the subject, the last resort of certificates without SANs.
+	CommonName string
+}
+
+// FromCertificate reads the identity of a verified leaf certificate.
+func FromCertificate(cert *x509.Certificate) Identity {
+	id := Identity{
+		SPIFFEID:   "",
+		URIs:       make([]string, 0, len(cert.URIs)),
+		DNSNames:   cert.DNSNames,
+		CommonName: cert.Subject.CommonName,
+	}
+
+	for _, uri := range cert.URIs {
+		// an SVID has exactly one URI SAN, the first spiffe one wins otherwise
+		if uri.Scheme == spiffeScheme && id.SPIFFEID == "" {
+			id.SPIFFEID = uri.String()
+		}
+
+		id.URIs = append(id.URIs, uri.String())
+	}
+
+	return id
+}
+
+// Name is the most specific name of the identity: its SPIFFE ID, its first DNS name or its common name.
+func (i Identity) Name() string {
+	switch {
+	case i.SPIFFEID != "":
+		return i.SPIFFEID
+	case len(i.DNSNames) > 0:
+		return i.DNSNames[0]
+	default:
+		return i.CommonName
+	}
+}
+
+type identityKey struct{}
+
+// WithIdentity returns a copy of ctx carrying the identity of the client.
+func WithIdentity(ctx context.Context, id Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, id)
+}
+
+// FromContext returns the identity of the client, false for plaintext calls and clients without certificates.
+func FromContext(ctx context.Context) (Identity, bool) {
+	id, ok := ctx.Value(identityKey{}).(Identity)
+
+	return id, ok
+}
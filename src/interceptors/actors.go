@@ -0,0 +1,61 @@
+package interceptors
+
+import (
+	"context"
+	"crypto/x509"
+	"strings"
+
+	grpcMiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/ingvarmattis/example/src/actor"
+	"github.com/ingvarmattis/example/src/identity"
+)
+
+// UnaryServerActorInterceptor attributes the changes made by a call to its caller,
+// so that they show up with a name in the history of the registry. It runs after the identity interceptor,
+// isGateway is the one the identity interceptor is given.
+func UnaryServerActorInterceptor(isGateway func(*x509.Certificate) bool) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		return handler(actor.WithActor(ctx, callerActor(ctx, isGateway)), req)
+	}
+}
+
+func StreamServerActorInterceptor(isGateway func(*x509.Certificate) bool) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		wrapped := grpcMiddleware.WrapServerStream(ss)
+		wrapped.WrappedContext = actor.WithActor(ss.Context(), callerActor(ss.Context(), isGateway))
+
+		return handler(srv, wrapped)
+	}
+}
+
+// callerActor prefers the identity of the client certificate, a client that has one cannot claim another
+// name with the x-actor header. Other clients are named by the header, then by their address.
+func callerActor(ctx context.Context, isGateway func(*x509.Certificate) bool) string {
+	if id, ok := identity.FromContext(ctx); ok && id.Name() != "" {
+		return id.Name()
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	if values := md.Get(actor.Header); len(values) > 0 && values[0] != "" {
+		return values[0]
+	}
+
+	// calls proxied by grpc-gateway come from the gateway itself, it appends the address of its client
+	// to x-forwarded-for; the header is the client's own on calls of anyone else
+	if values := md.Get("x-forwarded-for"); len(values) > 0 && fromGateway(ctx, isGateway) {
+		if addresses := strings.Split(values[len(values)-1], ","); strings.TrimSpace(addresses[len(addresses)-1]) != "" {
+			return "peer:" + strings.TrimSpace(addresses[len(addresses)-1])
+		}
+	}
+
+	if p, ok := peer.FromContext(ctx); ok {
+		return "peer:" + p.Addr.String()
+	}
+
+	return actor.Unknown
+}
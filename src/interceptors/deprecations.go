@@ -0,0 +1,71 @@
+package interceptors
+
+import (
+	"context"
+	"crypto/x509"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/ingvarmattis/example/src/deprecation"
+)
+
+// UnaryServerDeprecationInterceptor tells callers of deprecated methods what replaces them.
+// The gateway forwards the headers as Deprecation and Link.
+func UnaryServerDeprecationInterceptor(notices deprecation.Notices) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if notice, ok := notices.Lookup(info.FullMethod); ok {
+			// best effort, a handler that has already sent its headers keeps them
+			_ = grpc.SetHeader(ctx, deprecationHeaders(notice))
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func StreamServerDeprecationInterceptor(notices deprecation.Notices) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if notice, ok := notices.Lookup(info.FullMethod); ok {
+			_ = ss.SetHeader(deprecationHeaders(notice))
+		}
+
+		return handler(srv, ss)
+	}
+}
+
+func deprecationHeaders(notice deprecation.Notice) metadata.MD {
+	return metadata.Pairs(
+		deprecation.Header, notice.HeaderValue(),
+		deprecation.LinkHeader, notice.LinkValue(),
+	)
+}
+
+// notGateway trusts no certificate as the one of the gateway. The log interceptor runs before the identity
+// of the caller is known, its caller is a hint and calls over TLS are only named by what they claim.
+func notGateway(*x509.Certificate) bool {
+	return false
+}
+
+// deprecationFields name the caller of a deprecated method in its log line, so that the remaining
+// callers can be found before the method is removed.
+func deprecationFields(ctx context.Context, notices deprecation.Notices, fullMethod string) []zap.Field {
+	notice, ok := notices.Lookup(fullMethod)
+	if !ok {
+		return nil
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	userAgent := md.Get("grpcgateway-user-agent")
+	if len(userAgent) == 0 {
+		userAgent = md.Get("user-agent")
+	}
+
+	return []zap.Field{
+		zap.Bool("deprecated", true),
+		zap.String("successor", notice.Successor),
+		zap.String("caller", callerActor(ctx, notGateway)),
+		zap.Strings("userAgent", userAgent),
+	}
+}
@@ -0,0 +1,96 @@
+package interceptors
+
+import (
+	"context"
+	"crypto/x509"
+
+	grpcMiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/ingvarmattis/example/src/identity"
+)
+
+// UnaryServerIdentityInterceptor exposes the identity of the client certificate to handlers, see identity.FromContext.
+// isGateway tells the calls of the gateway apart, they carry the certificate of the HTTP client in metadata.
+func UnaryServerIdentityInterceptor(isGateway func(*x509.Certificate) bool) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if id, ok := callerIdentity(ctx, isGateway); ok {
+			ctx = identity.WithIdentity(ctx, id)
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func StreamServerIdentityInterceptor(isGateway func(*x509.Certificate) bool) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		id, ok := callerIdentity(ss.Context(), isGateway)
+		if !ok {
+			return handler(srv, ss)
+		}
+
+		wrapped := grpcMiddleware.WrapServerStream(ss)
+		wrapped.WrappedContext = identity.WithIdentity(ss.Context(), id)
+
+		return handler(srv, wrapped)
+	}
+}
+
+// inProcessNetwork is the network of the in-memory listener the gateway calls the gRPC server through,
+// only the gateway can reach it.
+const inProcessNetwork = "bufconn"
+
+func callerIdentity(ctx context.Context, isGateway func(*x509.Certificate) bool) (identity.Identity, bool) {
+	if leaf, ok := peerCertificate(ctx); ok && !isGateway(leaf) {
+		return identity.FromCertificate(leaf), true
+	}
+
+	if !fromGateway(ctx, isGateway) {
+		return identity.Identity{}, false
+	}
+
+	// the gateway has verified the certificate of its client, the identity is of that client
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	forwarded := md.Get(identity.ForwardedCertHeader)
+	if len(forwarded) == 0 {
+		return identity.Identity{}, false
+	}
+
+	cert, err := x509.ParseCertificate([]byte(forwarded[0]))
+	if err != nil {
+		return identity.Identity{}, false
+	}
+
+	return identity.FromCertificate(cert), true
+}
+
+// fromGateway reports whether the call is made by the gateway on behalf of an HTTP client, over the in-process
+// listener or with the certificate of the server. Headers the gateway sets are only trusted on such calls.
+func fromGateway(ctx context.Context, isGateway func(*x509.Certificate) bool) bool {
+	if leaf, ok := peerCertificate(ctx); ok {
+		return isGateway(leaf)
+	}
+
+	p, ok := peer.FromContext(ctx)
+
+	return ok && p.Addr != nil && p.Addr.Network() == inProcessNetwork
+}
+
+// peerCertificate is the verified leaf certificate of the caller.
+func peerCertificate(ctx context.Context) (*x509.Certificate, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, false
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.PeerCertificates) == 0 {
+		return nil, false
+	}
+
+	return tlsInfo.State.PeerCertificates[0], true
+}
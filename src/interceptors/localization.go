@@ -0,0 +1,32 @@
+package interceptors
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"github.com/ingvarmattis/example/src/i18n"
+)
+
+// UnaryServerLocalizationInterceptor adds a LocalizedMessage in the language the caller asks for with
+// the accept-language metadata to the errors that have an ErrorInfo.
+func UnaryServerLocalizationInterceptor(bundles *i18n.Bundles) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return resp, bundles.Localize(ctx, err)
+		}
+
+		return resp, nil
+	}
+}
+
+func StreamServerLocalizationInterceptor(bundles *i18n.Bundles) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := handler(srv, ss); err != nil {
+			return bundles.Localize(ss.Context(), err)
+		}
+
+		return nil
+	}
+}
@@ -10,10 +10,14 @@ import (
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
+	"github.com/ingvarmattis/example/src/deprecation"
 	"github.com/ingvarmattis/example/src/log"
 )
 
-func UnaryServerLogInterceptor(logger *log.Zap, debugMode bool) grpc.UnaryServerInterceptor {
+// UnaryServerLogInterceptor logs every call, calls of deprecated methods also name their caller.
+func UnaryServerLogInterceptor(
+	logger *log.Zap, debugMode bool, deprecated deprecation.Notices,
+) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
 		startTime := time.Now()
 
@@ -23,18 +27,9 @@ func UnaryServerLogInterceptor(logger *log.Zap, debugMode bool) grpc.UnaryServer
 
 		executionDuration := time.Since(startTime)
 
-		var protocol string
-		if md, ok := metadata.FromIncomingContext(ctx); ok {
-			if len(md.Get("grpcgateway-user-agent")) > 0 {
-				protocol = "http"
-			} else {
-				protocol = "grpc"
-			}
-		}
-
 		fields := []zap.Field{
 			zap.String("method", info.FullMethod),
-			zap.String("protocol", protocol),
+			zap.String("protocol", requestProtocol(ctx)),
 			zap.Duration("duration", executionDuration),
 			zap.String("status", status.Code(err).String()),
 		}
@@ -43,6 +38,8 @@ func UnaryServerLogInterceptor(logger *log.Zap, debugMode bool) grpc.UnaryServer
 			fields = append(fields, zap.String("traceID", traceID.String()))
 		}
 
+		fields = append(fields, deprecationFields(ctx, deprecated, info.FullMethod)...)
+
 		if debugMode {
 			fields = append(fields, zap.Any("request", req), zap.Any("response", resp))
 		}
@@ -52,3 +49,45 @@ func UnaryServerLogInterceptor(logger *log.Zap, debugMode bool) grpc.UnaryServer
 		return resp, err
 	}
 }
+
+func StreamServerLogInterceptor(logger *log.Zap, deprecated deprecation.Notices) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		startTime := time.Now()
+
+		ctx := ss.Context()
+		traceID := trace.SpanFromContext(ctx).SpanContext().TraceID()
+
+		err := handler(srv, ss)
+
+		fields := []zap.Field{
+			zap.String("method", info.FullMethod),
+			zap.String("protocol", requestProtocol(ctx)),
+			zap.Duration("duration", time.Since(startTime)),
+			zap.String("status", status.Code(err).String()),
+		}
+
+		if traceID.IsValid() {
+			fields = append(fields, zap.String("traceID", traceID.String()))
+		}
+
+		fields = append(fields, deprecationFields(ctx, deprecated, info.FullMethod)...)
+
+		logger.Info("incoming stream", fields...)
+
+		return err
+	}
+}
+
+// requestProtocol tells calls proxied by grpc-gateway apart from native gRPC calls.
+func requestProtocol(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	if len(md.Get("grpcgateway-user-agent")) > 0 {
+		return "http"
+	}
+
+	return "grpc"
+}
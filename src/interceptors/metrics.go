@@ -7,7 +7,6 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
@@ -42,14 +41,7 @@ func UnaryServerMetricsInterceptor(enabled bool, serviceName string) grpc.UnaryS
 	) (any, error) {
 		start := time.Now()
 
-		var subsystem string
-		if md, ok := metadata.FromIncomingContext(ctx); ok {
-			if len(md.Get("grpcgateway-user-agent")) > 0 {
-				subsystem = "http"
-			} else {
-				subsystem = "grpc"
-			}
-		}
+		subsystem := requestProtocol(ctx)
 
 		method := extractShortMethodName(info.FullMethod)
 
@@ -64,6 +56,46 @@ func UnaryServerMetricsInterceptor(enabled bool, serviceName string) grpc.UnaryS
 	}
 }
 
+func StreamServerMetricsInterceptor(enabled bool, serviceName string) grpc.StreamServerInterceptor {
+	if !enabled {
+		return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			return handler(srv, ss)
+		}
+	}
+
+	serviceName = strings.ReplaceAll(serviceName, "-", "_")
+
+	streamDurations := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "streams_duration_seconds",
+		Help:    "Stream lifetime by method and error code.",
+		Buckets: []float64{.1, 1, 10, 30, 60, 300, 900, 1800, 3600},
+	}, []string{"service", "subsystem", "method", "code"})
+
+	streamErrors := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "error_streams_count",
+		Help: "Error streams count by method and error code.",
+	}, []string{"service", "subsystem", "method", "code"})
+
+	prometheus.MustRegister(streamDurations, streamErrors)
+
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+
+		subsystem := requestProtocol(ss.Context())
+		method := extractShortMethodName(info.FullMethod)
+
+		err := handler(srv, ss)
+		if err != nil {
+			streamErrors.WithLabelValues(serviceName, subsystem, method, status.Code(err).String()).Inc()
+		}
+
+		streamDurations.WithLabelValues(serviceName, subsystem, method, status.Code(err).String()).
+			Observe(time.Since(start).Seconds())
+
+		return err
+	}
+}
+
 func extractShortMethodName(fullMethod string) string {
 	if idx := strings.LastIndex(fullMethod, "/"); idx != -1 {
 		return fullMethod[idx+1:]
@@ -7,6 +7,8 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/ingvarmattis/example/src/log"
 )
@@ -36,3 +38,37 @@ func UnaryServerPanicsInterceptor(logger *log.Zap, serviceName string) grpc.Unar
 		return handler(ctx, req)
 	}
 }
+
+func StreamServerPanicsInterceptor(logger *log.Zap, serviceName string) grpc.StreamServerInterceptor {
+	serviceName = strings.ReplaceAll(serviceName, "-", "_")
+
+	panicsCounter := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: serviceName,
+		Subsystem: "grpc_stream",
+		Name:      "panics_count",
+		Help:      "Stream panics count by method.",
+	}, []string{"method"})
+
+	prometheus.MustRegister(panicsCounter)
+
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		method := extractShortMethodName(info.FullMethod)
+
+		var err error
+
+		func() {
+			defer func() {
+				if r := recover(); r != nil {
+					logger.Warn("panic: " + string(debug.Stack()))
+					panicsCounter.WithLabelValues(method).Inc()
+
+					err = status.Error(codes.Internal, "internal error")
+				}
+			}()
+
+			err = handler(srv, ss)
+		}()
+
+		return err
+	}
+}
@@ -3,12 +3,18 @@ package interceptors
 import (
 	"context"
 
+	grpcMiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"go.opentelemetry.io/otel/attribute"
 	otelCodes "go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
+// TraceIDHeader is the response header with the trace of a call, so that a failure reported by a client
+// can be found in the traces.
+const TraceIDHeader = "x-trace-id"
+
 func UnaryServerTraceInterceptor(tracer trace.Tracer, serviceName string) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
 		ctx, span := tracer.Start(ctx, info.FullMethod)
@@ -16,6 +22,10 @@ func UnaryServerTraceInterceptor(tracer trace.Tracer, serviceName string) grpc.U
 
 		span.SetAttributes(attribute.String("product", serviceName))
 
+		if traceID := span.SpanContext().TraceID(); traceID.IsValid() {
+			_ = grpc.SetHeader(ctx, metadata.Pairs(TraceIDHeader, traceID.String()))
+		}
+
 		resp, err := handler(ctx, req)
 
 		SetSpanStatus(span, err)
@@ -24,6 +34,28 @@ func UnaryServerTraceInterceptor(tracer trace.Tracer, serviceName string) grpc.U
 	}
 }
 
+func StreamServerTraceInterceptor(tracer trace.Tracer, serviceName string) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, span := tracer.Start(ss.Context(), info.FullMethod)
+		defer span.End()
+
+		span.SetAttributes(attribute.String("product", serviceName))
+
+		if traceID := span.SpanContext().TraceID(); traceID.IsValid() {
+			_ = ss.SetHeader(metadata.Pairs(TraceIDHeader, traceID.String()))
+		}
+
+		wrapped := grpcMiddleware.WrapServerStream(ss)
+		wrapped.WrappedContext = ctx
+
+		err := handler(srv, wrapped)
+
+		SetSpanStatus(span, err)
+
+		return err
+	}
+}
+
 func SetSpanStatus(span trace.Span, err error) {
 	if err != nil {
 		span.RecordError(err)
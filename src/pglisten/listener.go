@@ -0,0 +1,194 @@
+package pglisten
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+
+	"github.com/ingvarmattis/example/src/log"
+)
+
+const (
+	minReconnectDelay = 100 * time.Millisecond
+	maxReconnectDelay = 30 * time.Second
+)
+
+// Listener holds a dedicated connection that LISTENs on a channel whose payloads are monotonically
+// increasing revisions, and fans them out to in-process subscribers.
+//
+// Notifications sent while the connection is down are lost, so after every (re)connect and whenever
+// a revision is skipped, subscribers get ResyncRevision and have to re-read the source of the revisions.
+type Listener struct {
+	pool    *pgxpool.Pool
+	channel string
+	logger  *log.Zap
+
+	mu          sync.Mutex
+	subscribers map[chan int64]struct{}
+
+	stop    context.CancelFunc
+	stopped chan struct{}
+}
+
+// ResyncRevision is delivered instead of a revision when notifications may have been missed.
+const ResyncRevision int64 = 0
+
+func NewListener(pool *pgxpool.Pool, channel string, logger *log.Zap) *Listener {
+	return &Listener{
+		pool:        pool,
+		channel:     channel,
+		logger:      logger.WithFields(zap.String("channel", channel)),
+		subscribers: make(map[chan int64]struct{}),
+		stop:        nil,
+		stopped:     make(chan struct{}),
+	}
+}
+
+// Subscribe returns a channel of revisions and a function that cancels the subscription.
+// The channel holds only the latest undelivered value, a slow subscriber never blocks the listener.
+func (l *Listener) Subscribe() (<-chan int64, func()) {
+	subscriber := make(chan int64, 1)
+
+	l.mu.Lock()
+	l.subscribers[subscriber] = struct{}{}
+	l.mu.Unlock()
+
+	return subscriber, func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+
+		delete(l.subscribers, subscriber)
+	}
+}
+
+// Listen blocks until ctx is done or Close is called, reconnecting with an exponential backoff.
+func (l *Listener) Listen(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+
+	l.mu.Lock()
+	l.stop = cancel
+	l.mu.Unlock()
+
+	defer close(l.stopped)
+	defer cancel()
+
+	l.logger.Info("starting postgres listener")
+
+	var lastRevision int64
+
+	delay := minReconnectDelay
+
+	for {
+		connected, err := l.listen(ctx, &lastRevision)
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		if connected {
+			delay = minReconnectDelay
+		}
+
+		l.logger.Warn("postgres listener disconnected", zap.Error(err), zap.Duration("reconnectIn", delay))
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(delay):
+		}
+
+		delay = min(delay*2, maxReconnectDelay)
+	}
+}
+
+// Close stops Listen and waits for the connection to be closed.
+func (l *Listener) Close() {
+	l.mu.Lock()
+	stop := l.stop
+	l.mu.Unlock()
+
+	if stop == nil {
+		return
+	}
+
+	stop()
+
+	select {
+	case <-l.stopped:
+	case <-time.After(maxReconnectDelay):
+	}
+}
+
+// listen serves a single connection and reports whether LISTEN succeeded before it failed.
+func (l *Listener) listen(ctx context.Context, lastRevision *int64) (bool, error) {
+	pooled, err := l.pool.Acquire(ctx)
+	if err != nil {
+		return false, fmt.Errorf("cannot acquire connection | %w", err)
+	}
+
+	// the connection leaves the pool, so it does not go back with LISTEN still active on it
+	conn := pooled.Hijack()
+	defer func() {
+		closeCTX, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		_ = conn.Close(closeCTX)
+	}()
+
+	if _, err = conn.Exec(ctx, "listen "+pgx.Identifier{l.channel}.Sanitize()); err != nil {
+		return false, fmt.Errorf("cannot listen | %w", err)
+	}
+
+	// anything committed while there was no connection has to be picked up from the source
+	l.broadcast(ResyncRevision)
+
+	for {
+		notification, waitErr := conn.WaitForNotification(ctx)
+		if waitErr != nil {
+			return true, fmt.Errorf("cannot wait for notification | %w", waitErr)
+		}
+
+		revision, parseErr := strconv.ParseInt(notification.Payload, 10, 64)
+		if parseErr != nil {
+			l.logger.Warn("unexpected notification payload", zap.String("payload", notification.Payload))
+			l.broadcast(ResyncRevision)
+
+			continue
+		}
+
+		if *lastRevision != 0 && revision > *lastRevision+1 {
+			l.logger.Warn("revision gap detected",
+				zap.Int64("lastRevision", *lastRevision), zap.Int64("revision", revision))
+			l.broadcast(ResyncRevision)
+		}
+
+		*lastRevision = max(*lastRevision, revision)
+
+		l.broadcast(revision)
+	}
+}
+
+func (l *Listener) broadcast(revision int64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for subscriber := range l.subscribers {
+		next := revision
+
+		// the newest revision supersedes an undelivered one, but a pending resync must survive
+		select {
+		case pending := <-subscriber:
+			if pending == ResyncRevision {
+				next = ResyncRevision
+			}
+		default:
+		}
+
+		subscriber <- next
+	}
+}
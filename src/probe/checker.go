@@ -0,0 +1,102 @@
+package probe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	ProtocolGRPC = "grpc"
+	ProtocolHTTP = "http"
+
+	// maxDrainedBody bounds how much of a response body is read to let the connection be reused.
+	maxDrainedBody = 4 << 10
+)
+
+var (
+	ErrUnsupportedProtocol = errors.New("unsupported probe protocol")
+	ErrNotServing          = errors.New("service is not serving")
+)
+
+// Checker runs a single health check against a probe address. The deadline of the check is taken from ctx.
+type Checker struct {
+	httpClient *http.Client
+}
+
+func NewChecker() *Checker {
+	return &Checker{
+		httpClient: &http.Client{
+			// a redirect to a login page or similar must not count as healthy
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+// Check returns nil when the service behind address is healthy.
+func (c *Checker) Check(ctx context.Context, protocol, address string) error {
+	switch protocol {
+	case ProtocolGRPC:
+		return c.checkGRPC(ctx, address)
+	case ProtocolHTTP:
+		return c.checkHTTP(ctx, address)
+	default:
+		return fmt.Errorf("%w %q", ErrUnsupportedProtocol, protocol)
+	}
+}
+
+// checkGRPC calls grpc.health.v1.Health/Check for the whole server.
+func (c *Checker) checkGRPC(ctx context.Context, address string) error {
+	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return fmt.Errorf("cannot create grpc client | %w", err)
+	}
+	defer conn.Close()
+
+	resp, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+	if err != nil {
+		return fmt.Errorf("cannot check health | %w", err)
+	}
+
+	if resp.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+		return fmt.Errorf("%w: %s", ErrNotServing, resp.GetStatus())
+	}
+
+	return nil
+}
+
+// checkHTTP sends a GET and expects a 2xx response. Addresses without a scheme are requested over plain http.
+func (c *Checker) checkHTTP(ctx context.Context, address string) error {
+	url := address
+	if !strings.HasPrefix(url, "http://") && !strings.HasPrefix(url, "https://") {
+		url = "http://" + url
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+	if err != nil {
+		return fmt.Errorf("cannot create request | %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("cannot send request | %w", err)
+	}
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxDrainedBody))
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("%w: %s", ErrNotServing, resp.Status)
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package probe
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestCheckHTTP(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler http.HandlerFunc
+		wantErr error
+	}{
+		{
+			name:    "ok",
+			handler: func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusNoContent) },
+			wantErr: nil,
+		},
+		{
+			name:    "server error",
+			handler: func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusServiceUnavailable) },
+			wantErr: ErrNotServing,
+		},
+		{
+			name: "redirect",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				http.Redirect(w, r, "/login", http.StatusFound)
+			},
+			wantErr: ErrNotServing,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(tt.handler)
+			defer server.Close()
+
+			// the address of a probe usually comes without a scheme
+			address := strings.TrimPrefix(server.URL, "http://")
+
+			err := NewChecker().Check(context.Background(), ProtocolHTTP, address)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Check() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCheckHTTPTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if err := NewChecker().Check(ctx, ProtocolHTTP, server.URL); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Check() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestCheckGRPC(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  healthpb.HealthCheckResponse_ServingStatus
+		wantErr error
+	}{
+		{name: "serving", status: healthpb.HealthCheckResponse_SERVING, wantErr: nil},
+		{name: "not serving", status: healthpb.HealthCheckResponse_NOT_SERVING, wantErr: ErrNotServing},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			address := serveHealth(t, tt.status)
+
+			err := NewChecker().Check(context.Background(), ProtocolGRPC, address)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Check() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCheckGRPCUnreachable(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	address := listener.Addr().String()
+	_ = listener.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	if err = NewChecker().Check(ctx, ProtocolGRPC, address); err == nil {
+		t.Fatal("Check() of a closed port succeeded")
+	}
+}
+
+func TestCheckUnsupportedProtocol(t *testing.T) {
+	if err := NewChecker().Check(context.Background(), "tcp", "localhost:1"); !errors.Is(err, ErrUnsupportedProtocol) {
+		t.Fatalf("Check() error = %v, want %v", err, ErrUnsupportedProtocol)
+	}
+}
+
+// serveHealth starts a gRPC server whose health service reports status and returns its address.
+func serveHealth(t *testing.T, status healthpb.HealthCheckResponse_ServingStatus) string {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus("", status)
+
+	server := grpc.NewServer()
+	healthpb.RegisterHealthServer(server, healthServer)
+
+	go func() { _ = server.Serve(listener) }()
+
+	t.Cleanup(server.Stop)
+
+	return listener.Addr().String()
+}
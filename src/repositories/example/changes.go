@@ -0,0 +1,177 @@
+package example
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+type ChangeType string
+
+const (
+	ChangeTypeAdded   ChangeType = "added"
+	ChangeTypeUpdated ChangeType = "updated"
+	ChangeTypeRemoved ChangeType = "removed"
+	// ChangeTypeInstanceAdded is recorded again when a registered instance changes its endpoints.
+	ChangeTypeInstanceAdded   ChangeType = "instance_added"
+	ChangeTypeInstanceRemoved ChangeType = "instance_removed"
+)
+
+// ChangesChannel is the LISTEN/NOTIFY channel every recorded change is announced on with its revision as payload.
+// Notifications are delivered on commit, so other replicas learn about a change once it is readable.
+const ChangesChannel = "example_service_changes"
+
+// Change is a row of example.service_changes, the append-only log that watchers are fed from.
+type Change struct {
+	Revision int64
+	Type     ChangeType
+	// Service is the state after the change, or the last known state for ChangeTypeRemoved.
+	Service *Service
+	// Instance is set for ChangeTypeInstanceAdded and ChangeTypeInstanceRemoved only,
+	// with the last known state for the latter.
+	Instance  *Instance
+	ChangedAt time.Time
+}
+
+// recordChange appends a change to the log inside tx. Writers are serialized by a transaction-level
+// advisory lock, so revisions become visible in the order they were allocated and a reader that has
+// seen revision N has also seen every revision below it. The revision is announced on ChangesChannel.
+func recordChange(ctx context.Context, tx pgx.Tx, changeType ChangeType, service *Service) error {
+	return appendChange(ctx, tx, changeType, service, nil)
+}
+
+// recordInstanceChange appends a change of an instance of service, see recordChange.
+func recordInstanceChange(
+	ctx context.Context, tx pgx.Tx, changeType ChangeType, service *Service, instance *Instance,
+) error {
+	return appendChange(ctx, tx, changeType, service, instance)
+}
+
+func appendChange(ctx context.Context, tx pgx.Tx, changeType ChangeType, service *Service, instance *Instance) error {
+	lockQuery := `select pg_advisory_xact_lock(hashtext('example.service_changes'));`
+
+	if _, err := tx.Exec(ctx, lockQuery); err != nil {
+		return fmt.Errorf("cannot lock service changes | %w", err)
+	}
+
+	snapshot, err := json.Marshal(service)
+	if err != nil {
+		return fmt.Errorf("cannot marshal service snapshot | %w", err)
+	}
+
+	query := `
+insert into example.service_changes (change_type, service_name, service, instance)
+values ($1, $2, $3, $4)
+returning revision;`
+
+	var revision int64
+	if err = tx.QueryRow(ctx, query, string(changeType), service.Name, snapshot, instance).Scan(&revision); err != nil {
+		return fmt.Errorf("cannot record service change | %w", err)
+	}
+
+	notifyQuery := `select pg_notify($1, $2);`
+
+	if _, err = tx.Exec(ctx, notifyQuery, ChangesChannel, strconv.FormatInt(revision, 10)); err != nil {
+		return fmt.Errorf("cannot notify service change | %w", err)
+	}
+
+	return nil
+}
+
+// ListChanges returns up to limit changes with a revision greater than afterRevision in revision order.
+func (p *Postgres) ListChanges(ctx context.Context, afterRevision int64, limit int) ([]*Change, error) {
+	ctx, span := otel.Tracer(packageName).Start(ctx, "ListChanges")
+	defer span.End()
+
+	query := `
+select revision, change_type, service, instance, changed_at
+from example.service_changes
+where revision > $1
+order by revision
+limit $2;`
+
+	span.SetAttributes(attribute.String("query", query))
+
+	rows, err := p.pool.Query(ctx, query, afterRevision, limit)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, fmt.Errorf("cannot list service changes | %w", err)
+	}
+
+	changes, err := pgx.CollectRows(rows, scanChange)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, fmt.Errorf("cannot list service changes | %w", err)
+	}
+
+	return changes, nil
+}
+
+// RevisionRange returns the oldest and the latest revision still kept in the change log, zeros when it is empty.
+func (p *Postgres) RevisionRange(ctx context.Context) (int64, int64, error) {
+	ctx, span := otel.Tracer(packageName).Start(ctx, "RevisionRange")
+	defer span.End()
+
+	query := `
+select coalesce(min(revision), 0), coalesce(max(revision), 0)
+from example.service_changes;`
+
+	span.SetAttributes(attribute.String("query", query))
+
+	var oldest, latest int64
+	if err := p.pool.QueryRow(ctx, query).Scan(&oldest, &latest); err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return 0, 0, fmt.Errorf("cannot get revision range | %w", err)
+	}
+
+	return oldest, latest, nil
+}
+
+// PruneChanges deletes changes older than retention. The latest change is always kept,
+// so the current revision survives restarts even when the registry is idle.
+func (p *Postgres) PruneChanges(ctx context.Context, retention time.Duration) (int64, error) {
+	ctx, span := otel.Tracer(packageName).Start(ctx, "PruneChanges")
+	defer span.End()
+
+	query := `
+delete from example.service_changes
+where changed_at < now() - $1::double precision * interval '1 second'
+  and revision < (select max(revision) from example.service_changes);`
+
+	span.SetAttributes(attribute.String("query", query))
+
+	tag, err := p.pool.Exec(ctx, query, retention.Seconds())
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return 0, fmt.Errorf("cannot prune service changes | %w", err)
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+func scanChange(row pgx.CollectableRow) (*Change, error) {
+	var (
+		change     Change
+		changeType string
+		snapshot   []byte
+	)
+
+	if err := row.Scan(&change.Revision, &changeType, &snapshot, &change.Instance, &change.ChangedAt); err != nil {
+		return nil, err
+	}
+
+	change.Type = ChangeType(changeType)
+
+	if err := json.Unmarshal(snapshot, &change.Service); err != nil {
+		return nil, fmt.Errorf("cannot unmarshal service snapshot | %w", err)
+	}
+
+	return &change, nil
+}
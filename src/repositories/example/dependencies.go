@@ -0,0 +1,269 @@
+package example
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// UnknownDependencyError rejects a declaration of dependencies that are not registered.
+type UnknownDependencyError struct {
+	// Names is empty when a dependency was deleted concurrently with the declaration.
+	Names []string
+}
+
+func (e *UnknownDependencyError) Error() string {
+	if len(e.Names) == 0 {
+		return "a dependency is not registered"
+	}
+
+	return "not registered: " + strings.Join(e.Names, ", ")
+}
+
+// DependencyCycleError rejects a declaration that would make the dependency graph cyclic.
+type DependencyCycleError struct {
+	ServiceName string
+	// Dependency is the declared dependency that already depends on ServiceName.
+	Dependency string
+}
+
+func (e *DependencyCycleError) Error() string {
+	if e.Dependency == e.ServiceName {
+		return e.ServiceName + " cannot depend on itself"
+	}
+
+	return e.Dependency + " already depends on " + e.ServiceName
+}
+
+type DependencyDirection int
+
+const (
+	// DependencyDirectionUpstream follows edges from a service to the services it depends on.
+	DependencyDirectionUpstream DependencyDirection = iota
+	// DependencyDirectionDownstream follows edges from a service to the services that depend on it.
+	DependencyDirectionDownstream
+)
+
+// DependencyEdge means ServiceName depends on DependsOn. Depth is the shortest distance
+// of the edge from the service the graph was walked from, starting at 1.
+type DependencyEdge struct {
+	ServiceName string
+	DependsOn   string
+	Depth       int
+}
+
+// dependencyGraphLockQuery serializes writers of the graph, otherwise two concurrent declarations
+// could each pass the cycle check and close a cycle together.
+const dependencyGraphLockQuery = `select pg_advisory_xact_lock(hashtext('example.service_dependencies'));`
+
+// setDependencies replaces the dependencies of a service inside tx, rejecting unknown services and cycles.
+func setDependencies(ctx context.Context, tx pgx.Tx, serviceName string, dependsOn []string) error {
+	if _, err := tx.Exec(ctx, dependencyGraphLockQuery); err != nil {
+		return fmt.Errorf("cannot lock service dependencies | %w", err)
+	}
+
+	if len(dependsOn) > 0 {
+		missingQuery := `
+select array(
+    select unnest($1::text[])
+    except
+    select service_name from example.services
+);`
+
+		var missing []string
+		if err := tx.QueryRow(ctx, missingQuery, dependsOn).Scan(&missing); err != nil {
+			return fmt.Errorf("cannot check dependencies | %w", err)
+		}
+
+		if len(missing) > 0 {
+			return &UnknownDependencyError{Names: missing}
+		}
+
+		// everything reachable from the new dependencies, a cycle appears if the service itself is among it
+		cycleQuery := `
+with recursive reachable(origin, service_name) as (
+    select dependency, dependency
+    from unnest($2::text[]) dependency
+  union
+    select r.origin, d.depends_on
+    from reachable r
+    join example.service_dependencies d on d.service_name = r.service_name
+)
+select origin
+from reachable
+where service_name = $1
+order by origin
+limit 1;`
+
+		var origin string
+
+		err := tx.QueryRow(ctx, cycleQuery, serviceName, dependsOn).Scan(&origin)
+		if err == nil {
+			return &DependencyCycleError{ServiceName: serviceName, Dependency: origin}
+		}
+
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("cannot check dependency cycles | %w", err)
+		}
+	}
+
+	deleteQuery := `
+delete from example.service_dependencies
+where service_name = $1;`
+
+	if _, err := tx.Exec(ctx, deleteQuery, serviceName); err != nil {
+		return fmt.Errorf("cannot delete dependencies | %w", err)
+	}
+
+	query := `
+insert into example.service_dependencies (service_name, depends_on)
+select $1, unnest($2::text[]);`
+
+	if _, err := tx.Exec(ctx, query, serviceName, dependsOn); err != nil {
+		if isForeignKeyViolation(err) {
+			return &UnknownDependencyError{Names: nil}
+		}
+
+		return fmt.Errorf("cannot set dependencies | %w", err)
+	}
+
+	return nil
+}
+
+// releaseDependents drops the dependencies on serviceNames, which are about to be deleted inside tx, as updates
+// of the services that declared them: every dependent gets a new resource version, a change and an event.
+// The cascade of the foreign key would drop them as well, but without anyone noticing.
+// The deleted services have to be locked already, so that no dependency on them can be added in the meantime.
+func releaseDependents(ctx context.Context, tx pgx.Tx, serviceNames []string) error {
+	dependentsQuery := `
+select distinct service_name
+from example.service_dependencies
+where depends_on = any($1)
+  and not service_name = any($1)
+order by service_name;`
+
+	rows, err := tx.Query(ctx, dependentsQuery, serviceNames)
+	if err != nil {
+		return fmt.Errorf("cannot list dependents | %w", err)
+	}
+
+	dependents, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return fmt.Errorf("cannot list dependents | %w", err)
+	}
+
+	deleteQuery := `
+delete from example.service_dependencies
+where service_name = $1
+  and depends_on = any($2);`
+
+	updateQuery := `
+update example.services
+set updated_at       = now(),
+    resource_version = resource_version + 1
+where service_name = $1
+returning ` + serviceColumns + `;`
+
+	for _, dependent := range dependents {
+		previous, lockErr := lockService(ctx, tx, dependent)
+		if lockErr != nil {
+			return fmt.Errorf("cannot lock dependent %s | %w", dependent, lockErr)
+		}
+
+		if _, err = tx.Exec(ctx, deleteQuery, dependent, serviceNames); err != nil {
+			return fmt.Errorf("cannot delete dependencies of %s | %w", dependent, err)
+		}
+
+		updated, updateErr := scanService(tx.QueryRow(ctx, updateQuery, dependent))
+		if updateErr != nil {
+			return fmt.Errorf("cannot update dependent %s | %w", dependent, updateErr)
+		}
+
+		if err = recordChange(ctx, tx, ChangeTypeUpdated, updated); err != nil {
+			return err
+		}
+
+		if err = recordEvent(ctx, tx, dependent, EventTypeUpdated, previous, updated); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ListDependencyEdges walks the graph from serviceName in direction and returns every edge it has passed,
+// at most maxDepth edges away from serviceName. Zero maxDepth walks the whole graph, which is finite
+// because cycles are rejected on write. An empty serviceName returns every edge of the registry at depth 1.
+func (p *Postgres) ListDependencyEdges(
+	ctx context.Context, serviceName string, direction DependencyDirection, maxDepth int,
+) ([]*DependencyEdge, error) {
+	ctx, span := otel.Tracer(packageName).Start(ctx, "ListDependencyEdges")
+	defer span.End()
+
+	query := dependencyEdgesQuery(serviceName, direction)
+
+	span.SetAttributes(attribute.String("query", query))
+
+	args := []any{serviceName, maxDepth}
+	if serviceName == "" {
+		args = nil
+	}
+
+	rows, err := p.pool.Query(ctx, query, args...)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, fmt.Errorf("cannot list dependency edges | %w", err)
+	}
+
+	edges, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*DependencyEdge, error) {
+		var edge DependencyEdge
+		if scanErr := row.Scan(&edge.ServiceName, &edge.DependsOn, &edge.Depth); scanErr != nil {
+			return nil, scanErr
+		}
+
+		return &edge, nil
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, fmt.Errorf("cannot list dependency edges | %w", err)
+	}
+
+	return edges, nil
+}
+
+func dependencyEdgesQuery(serviceName string, direction DependencyDirection) string {
+	if serviceName == "" {
+		return `
+select service_name, depends_on, 1
+from example.service_dependencies
+order by service_name, depends_on;`
+	}
+
+	// near is the end of an edge closer to the start of the walk, far is the other one
+	near, far := "service_name", "depends_on"
+	if direction == DependencyDirectionDownstream {
+		near, far = far, near
+	}
+
+	return `
+with recursive walk(service_name, depends_on, depth) as (
+    select d.service_name, d.depends_on, 1
+    from example.service_dependencies d
+    where d.` + near + ` = $1
+  union
+    select d.service_name, d.depends_on, w.depth + 1
+    from walk w
+    join example.service_dependencies d on d.` + near + ` = w.` + far + `
+    where $2::integer = 0 or w.depth < $2::integer
+)
+select service_name, depends_on, min(depth)
+from walk
+group by service_name, depends_on
+order by min(depth), service_name, depends_on;`
+}
@@ -0,0 +1,146 @@
+package example
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/ingvarmattis/example/src/actor"
+)
+
+type EventType string
+
+const (
+	EventTypeRegistered EventType = "registered"
+	EventTypeUpdated    EventType = "updated"
+	// EventTypeRenamed is recorded under both the old and the new name.
+	EventTypeRenamed      EventType = "renamed"
+	EventTypeExpired      EventType = "expired"
+	EventTypeRevived      EventType = "revived"
+	EventTypeUnregistered EventType = "unregistered"
+	// EventTypeDeleted is recorded when an expired registration is removed by the reaper.
+	EventTypeDeleted EventType = "deleted"
+)
+
+// Event is a row of example.service_events, the append-only audit trail of the registry.
+// Unlike example.service_changes it is never pruned.
+type Event struct {
+	ID          int64
+	ServiceName string
+	Type        EventType
+	Actor       string
+	// OldValue is nil for EventTypeRegistered, NewValue is nil for EventTypeUnregistered and EventTypeDeleted.
+	OldValue   *Service
+	NewValue   *Service
+	OccurredAt time.Time
+}
+
+type ServiceEventsFilter struct {
+	// ServiceName is empty for the events of every service, including removed ones.
+	ServiceName string
+	// From and To bound OccurredAt as [From, To), nil leaves the range open.
+	From *time.Time
+	To   *time.Time
+	// AfterID is zero for the first page.
+	AfterID int64
+	Limit   int
+}
+
+// recordEvent appends an audit event inside tx, attributed to the actor of ctx.
+func recordEvent(
+	ctx context.Context, tx pgx.Tx, serviceName string, eventType EventType, oldValue, newValue *Service,
+) error {
+	query := `
+insert into example.service_events (service_name, event_type, actor, old_value, new_value)
+values ($1, $2, $3, $4, $5);`
+
+	if _, err := tx.Exec(ctx, query,
+		serviceName, string(eventType), actor.FromContext(ctx), oldValue, newValue,
+	); err != nil {
+		return fmt.Errorf("cannot record service event | %w", err)
+	}
+
+	return nil
+}
+
+// ListServiceEvents returns events in the order they were recorded, starting after filter.AfterID.
+func (p *Postgres) ListServiceEvents(ctx context.Context, filter *ServiceEventsFilter) ([]*Event, error) {
+	ctx, span := otel.Tracer(packageName).Start(ctx, "ListServiceEvents")
+	defer span.End()
+
+	query, args := listServiceEventsQuery(filter)
+
+	span.SetAttributes(attribute.String("query", query))
+
+	rows, err := p.pool.Query(ctx, query, args...)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, fmt.Errorf("cannot list service events | %w", err)
+	}
+
+	events, err := pgx.CollectRows(rows, scanEvent)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, fmt.Errorf("cannot list service events | %w", err)
+	}
+
+	return events, nil
+}
+
+func listServiceEventsQuery(filter *ServiceEventsFilter) (string, []any) {
+	var args []any
+
+	placeholder := func(arg any) string {
+		args = append(args, arg)
+		return "$" + strconv.Itoa(len(args))
+	}
+
+	conditions := []string{
+		"id > " + placeholder(filter.AfterID),
+	}
+
+	if filter.ServiceName != "" {
+		conditions = append(conditions, "service_name = "+placeholder(filter.ServiceName))
+	}
+
+	if filter.From != nil {
+		conditions = append(conditions, "occurred_at >= "+placeholder(*filter.From))
+	}
+
+	if filter.To != nil {
+		conditions = append(conditions, "occurred_at < "+placeholder(*filter.To))
+	}
+
+	query := `
+select id, service_name, event_type, actor, old_value, new_value, occurred_at
+from example.service_events
+where ` + strings.Join(conditions, "\n  and ") + `
+order by id
+limit ` + placeholder(filter.Limit) + ";"
+
+	return query, args
+}
+
+func scanEvent(row pgx.CollectableRow) (*Event, error) {
+	var (
+		event     Event
+		eventType string
+	)
+
+	if err := row.Scan(
+		&event.ID, &event.ServiceName, &eventType, &event.Actor, &event.OldValue, &event.NewValue, &event.OccurredAt,
+	); err != nil {
+		return nil, err
+	}
+
+	event.Type = EventType(eventType)
+
+	return &event, nil
+}
@@ -0,0 +1,225 @@
+package example
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+const foreignKeyViolationCode = "23503"
+
+const (
+	// probeLockQuery takes the session lock of the prober, the replica holding it is the only one that probes,
+	// so every round counts a failure once and not once per replica.
+	probeLockQuery   = `select pg_try_advisory_lock(hashtext('example.service_health'));`
+	probeUnlockQuery = `select pg_advisory_unlock(hashtext('example.service_health'));`
+)
+
+// ProbeTarget is a service that has to be probed.
+type ProbeTarget struct {
+	ServiceName string
+	Probe       Endpoint
+}
+
+// ProbeResult is the outcome of a single probe, Error is empty when it succeeded.
+type ProbeResult struct {
+	ServiceName string
+	Latency     time.Duration
+	Error       string
+	ProbedAt    time.Time
+}
+
+// Health is a row of example.service_health, it only keeps the latest probe.
+type Health struct {
+	ConsecutiveFailures int
+	Latency             time.Duration
+	LastError           string
+	ProbedAt            time.Time
+}
+
+// ProbeLock is the lock of the prober. It lasts as long as the connection it was taken on.
+type ProbeLock struct {
+	conn *pgxpool.Conn
+}
+
+// TryLockProbes takes the lock of the prober, it returns nil while another replica holds it.
+func (p *Postgres) TryLockProbes(ctx context.Context) (*ProbeLock, error) {
+	ctx, span := otel.Tracer(packageName).Start(ctx, "TryLockProbes")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("query", probeLockQuery))
+
+	conn, err := p.pool.Acquire(ctx)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, fmt.Errorf("cannot acquire connection | %w", err)
+	}
+
+	var locked bool
+	if err = conn.QueryRow(ctx, probeLockQuery).Scan(&locked); err != nil {
+		conn.Release()
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, fmt.Errorf("cannot lock probes | %w", err)
+	}
+
+	if !locked {
+		conn.Release()
+		return nil, nil //nolint:nilnil // nil means another replica probes, it is not an error
+	}
+
+	return &ProbeLock{conn: conn}, nil
+}
+
+// Check returns an error once the lock is lost with its connection.
+func (l *ProbeLock) Check(ctx context.Context) error {
+	if err := l.conn.Ping(ctx); err != nil {
+		return fmt.Errorf("probe lock lost | %w", err)
+	}
+
+	return nil
+}
+
+// Release gives up the lock. A connection the lock cannot be given up on is closed, which drops it as well.
+func (l *ProbeLock) Release(ctx context.Context) {
+	if _, err := l.conn.Exec(ctx, probeUnlockQuery); err != nil {
+		_ = l.conn.Conn().Close(ctx)
+	}
+
+	l.conn.Release()
+}
+
+// ListProbeTargets returns every active service that has a probe.
+func (p *Postgres) ListProbeTargets(ctx context.Context) ([]*ProbeTarget, error) {
+	ctx, span := otel.Tracer(packageName).Start(ctx, "ListProbeTargets")
+	defer span.End()
+
+	query := `
+select service_name, probe
+from example.services
+where probe is not null
+  and expired_at is null;`
+
+	span.SetAttributes(attribute.String("query", query))
+
+	rows, err := p.pool.Query(ctx, query)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, fmt.Errorf("cannot list probe targets | %w", err)
+	}
+
+	targets, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*ProbeTarget, error) {
+		var target ProbeTarget
+		if scanErr := row.Scan(&target.ServiceName, &target.Probe); scanErr != nil {
+			return nil, scanErr
+		}
+
+		return &target, nil
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, fmt.Errorf("cannot list probe targets | %w", err)
+	}
+
+	return targets, nil
+}
+
+// SaveProbeResult replaces the health of a service with the result of its latest probe.
+// A result for a service that has been deleted in the meantime is dropped. Results are only saved
+// by the holder of the lock of the prober, see TryLockProbes.
+func (p *Postgres) SaveProbeResult(ctx context.Context, result *ProbeResult) error {
+	ctx, span := otel.Tracer(packageName).Start(ctx, "SaveProbeResult")
+	defer span.End()
+
+	query := `
+insert into example.service_health as h (service_name, consecutive_failures, latency_us, last_error, probed_at)
+values ($1, case when $3::text = '' then 0 else 1 end, $2, $3, $4)
+on conflict (service_name) do update
+set consecutive_failures = case when excluded.last_error = '' then 0 else h.consecutive_failures + 1 end,
+    latency_us           = excluded.latency_us,
+    last_error           = excluded.last_error,
+    probed_at            = excluded.probed_at;`
+
+	span.SetAttributes(attribute.String("query", query))
+
+	if _, err := p.pool.Exec(ctx, query,
+		result.ServiceName, result.Latency.Microseconds(), result.Error, result.ProbedAt,
+	); err != nil {
+		if isForeignKeyViolation(err) {
+			return nil
+		}
+
+		span.SetStatus(codes.Error, err.Error())
+
+		return fmt.Errorf("cannot save probe result | %w", err)
+	}
+
+	return nil
+}
+
+// GetHealth returns the health of a service, ErrNotFound when it has not been probed yet.
+func (p *Postgres) GetHealth(ctx context.Context, serviceName string) (*Health, error) {
+	ctx, span := otel.Tracer(packageName).Start(ctx, "GetHealth")
+	defer span.End()
+
+	query := `
+select consecutive_failures, latency_us, last_error, probed_at
+from example.service_health
+where service_name = $1;`
+
+	span.SetAttributes(attribute.String("query", query))
+
+	var (
+		health    Health
+		latencyUS int64
+	)
+
+	if err := p.pool.QueryRow(ctx, query, serviceName).Scan(
+		&health.ConsecutiveFailures, &latencyUS, &health.LastError, &health.ProbedAt,
+	); err != nil {
+		span.SetStatus(codes.Error, err.Error())
+
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+
+		return nil, fmt.Errorf("cannot get service health | %w", err)
+	}
+
+	health.Latency = time.Duration(latencyUS) * time.Microsecond
+
+	return &health, nil
+}
+
+func resetHealth(ctx context.Context, tx pgx.Tx, serviceName string) error {
+	query := `
+delete from example.service_health
+where service_name = $1;`
+
+	if _, err := tx.Exec(ctx, query, serviceName); err != nil {
+		return fmt.Errorf("cannot reset service health | %w", err)
+	}
+
+	return nil
+}
+
+func sameProbe(a, b *Endpoint) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
+
+func isForeignKeyViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationCode
+}
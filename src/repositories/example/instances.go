@@ -0,0 +1,410 @@
+package example
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+var ErrInstanceNotFound = errors.New("instance not found")
+
+// instanceColumns is the column list scanned by scanInstance, endpoints are aggregated
+// from example.service_instance_endpoints in the order of their primary key.
+const instanceColumns = `i.service_name, i.instance_id, i.lease_ttl_seconds, i.lease_expires_at, i.registered_at,
+       coalesce((
+           select jsonb_agg(jsonb_build_object('protocol', e.protocol, 'address', e.address)
+                            order by e.protocol, e.address)
+           from example.service_instance_endpoints e
+           where e.service_name = i.service_name
+             and e.instance_id = i.instance_id
+       ), '[]')`
+
+// Instance is a row of example.service_instances along with its endpoints. The json tags define
+// the snapshot stored in example.service_changes.
+type Instance struct {
+	ServiceName string     `json:"service_name"`
+	ID          string     `json:"instance_id"`
+	Endpoints   []Endpoint `json:"endpoints"`
+	// LeaseTTL is zero for instances that never expire, they stay until they are unregistered.
+	LeaseTTL       time.Duration `json:"lease_ttl"`
+	LeaseExpiresAt *time.Time    `json:"lease_expires_at"`
+	RegisteredAt   time.Time     `json:"registered_at"`
+}
+
+// RegisterInstance adds an instance to a registered service, or replaces the endpoints of an instance
+// registered before. Either way its lease starts over. Registering an instance again with the same
+// endpoints only renews the lease and is not recorded as a change.
+func (p *Postgres) RegisterInstance(ctx context.Context, instance *Instance) (*Instance, error) {
+	ctx, span := otel.Tracer(packageName).Start(ctx, "RegisterInstance")
+	defer span.End()
+
+	query := `
+insert into example.service_instances (service_name, instance_id, lease_ttl_seconds, lease_expires_at)
+values (
+    $1, $2, $3::bigint,
+    case when $3::bigint > 0 then now() + $3::bigint * interval '1 second' end
+)
+on conflict (service_name, instance_id) do update
+set lease_ttl_seconds = excluded.lease_ttl_seconds,
+    lease_expires_at  = excluded.lease_expires_at;`
+
+	deleteEndpointsQuery := `
+delete from example.service_instance_endpoints
+where service_name = $1
+  and instance_id = $2;`
+
+	insertEndpointsQuery := `
+insert into example.service_instance_endpoints (service_name, instance_id, protocol, address)
+select $1, $2, e.protocol, e.address
+from jsonb_to_recordset($3::jsonb) as e (protocol text, address text)
+on conflict do nothing;`
+
+	span.SetAttributes(attribute.String("query", query))
+
+	var registered *Instance
+
+	err := pgx.BeginFunc(ctx, p.pool, func(tx pgx.Tx) error {
+		// the service row serializes the writes to its instances and keeps it from being deleted meanwhile
+		service, txErr := lockService(ctx, tx, instance.ServiceName)
+		if txErr != nil {
+			return txErr
+		}
+
+		previous, txErr := lockInstance(ctx, tx, instance.ServiceName, instance.ID)
+		if txErr != nil && !errors.Is(txErr, pgx.ErrNoRows) {
+			return txErr
+		}
+
+		if _, txErr = tx.Exec(ctx, query,
+			instance.ServiceName, instance.ID, int64(instance.LeaseTTL.Seconds()),
+		); txErr != nil {
+			return txErr
+		}
+
+		if _, txErr = tx.Exec(ctx, deleteEndpointsQuery, instance.ServiceName, instance.ID); txErr != nil {
+			return txErr
+		}
+
+		if _, txErr = tx.Exec(ctx, insertEndpointsQuery,
+			instance.ServiceName, instance.ID, endpointsOrEmpty(instance.Endpoints),
+		); txErr != nil {
+			return txErr
+		}
+
+		registered, txErr = getInstance(ctx, tx, instance.ServiceName, instance.ID)
+		if txErr != nil {
+			return txErr
+		}
+
+		if previous != nil && slices.Equal(previous.Endpoints, registered.Endpoints) {
+			return nil
+		}
+
+		return recordInstanceChange(ctx, tx, ChangeTypeInstanceAdded, service, registered)
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+
+		return nil, fmt.Errorf("failed to register instance | %w", err)
+	}
+
+	return registered, nil
+}
+
+// InstanceHeartbeat renews the lease of an instance. An instance that missed its lease has been deleted
+// by then and has to register again. Renewals are not recorded as changes, as in Heartbeat.
+func (p *Postgres) InstanceHeartbeat(ctx context.Context, serviceName, instanceID string) (*Instance, error) {
+	ctx, span := otel.Tracer(packageName).Start(ctx, "InstanceHeartbeat")
+	defer span.End()
+
+	query := `
+update example.service_instances
+set lease_expires_at = case
+        when lease_ttl_seconds > 0 then now() + lease_ttl_seconds * interval '1 second'
+    end
+where service_name = $1
+  and instance_id = $2;`
+
+	span.SetAttributes(attribute.String("query", query))
+
+	var instance *Instance
+
+	err := pgx.BeginFunc(ctx, p.pool, func(tx pgx.Tx) error {
+		tag, txErr := tx.Exec(ctx, query, serviceName, instanceID)
+		if txErr != nil {
+			return txErr
+		}
+
+		if tag.RowsAffected() == 0 {
+			return ErrInstanceNotFound
+		}
+
+		instance, txErr = getInstance(ctx, tx, serviceName, instanceID)
+
+		return txErr
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+
+		if errors.Is(err, ErrInstanceNotFound) {
+			return nil, ErrInstanceNotFound
+		}
+
+		return nil, fmt.Errorf("failed to renew instance lease | %w", err)
+	}
+
+	return instance, nil
+}
+
+// DeleteInstance removes an instance of a service along with its endpoints.
+func (p *Postgres) DeleteInstance(ctx context.Context, serviceName, instanceID string) error {
+	ctx, span := otel.Tracer(packageName).Start(ctx, "DeleteInstance")
+	defer span.End()
+
+	query := `
+delete from example.service_instances
+where service_name = $1
+  and instance_id = $2;`
+
+	span.SetAttributes(attribute.String("query", query))
+
+	err := pgx.BeginFunc(ctx, p.pool, func(tx pgx.Tx) error {
+		service, txErr := lockService(ctx, tx, serviceName)
+		if txErr != nil {
+			return txErr
+		}
+
+		deleted, txErr := lockInstance(ctx, tx, serviceName, instanceID)
+		if txErr != nil {
+			if errors.Is(txErr, pgx.ErrNoRows) {
+				return ErrInstanceNotFound
+			}
+
+			return txErr
+		}
+
+		if _, txErr = tx.Exec(ctx, query, serviceName, instanceID); txErr != nil {
+			return txErr
+		}
+
+		return recordInstanceChange(ctx, tx, ChangeTypeInstanceRemoved, service, deleted)
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+
+		switch {
+		case errors.Is(err, ErrInstanceNotFound):
+			return ErrInstanceNotFound
+		case errors.Is(err, pgx.ErrNoRows):
+			return ErrNotFound
+		default:
+			return fmt.Errorf("failed to delete instance | %w", err)
+		}
+	}
+
+	return nil
+}
+
+// ListInstances returns the instances of a registered service ordered by their ids.
+func (p *Postgres) ListInstances(ctx context.Context, serviceName string) ([]*Instance, error) {
+	ctx, span := otel.Tracer(packageName).Start(ctx, "ListInstances")
+	defer span.End()
+
+	existsQuery := `
+select exists (
+    select service_name
+    from example.services
+    where service_name = $1
+);`
+
+	query := `
+select ` + instanceColumns + `
+from example.service_instances i
+where i.service_name = $1
+order by i.instance_id;`
+
+	span.SetAttributes(attribute.String("query", query))
+
+	var instances []*Instance
+
+	// a snapshot tells a service without instances apart from one deleted in between
+	err := pgx.BeginTxFunc(ctx, p.pool, pgx.TxOptions{IsoLevel: pgx.RepeatableRead}, func(tx pgx.Tx) error {
+		var exists bool
+		if txErr := tx.QueryRow(ctx, existsQuery, serviceName).Scan(&exists); txErr != nil {
+			return txErr
+		}
+
+		if !exists {
+			return ErrNotFound
+		}
+
+		rows, txErr := tx.Query(ctx, query, serviceName)
+		if txErr != nil {
+			return txErr
+		}
+
+		instances, txErr = pgx.CollectRows(rows, func(row pgx.CollectableRow) (*Instance, error) {
+			return scanInstance(row)
+		})
+
+		return txErr
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+
+		if errors.Is(err, ErrNotFound) {
+			return nil, ErrNotFound
+		}
+
+		return nil, fmt.Errorf("cannot list instances | %w", err)
+	}
+
+	return instances, nil
+}
+
+// DeleteExpiredInstances deletes the instances whose lease has run out and returns them.
+// Unlike services, instances are not kept around expired: nothing should connect to them anymore.
+func (p *Postgres) DeleteExpiredInstances(ctx context.Context) ([]*Instance, error) {
+	ctx, span := otel.Tracer(packageName).Start(ctx, "DeleteExpiredInstances")
+	defer span.End()
+
+	// services are locked before their instances, in the same order as RegisterInstance and DeleteInstance
+	lockServicesQuery := `
+select ` + serviceColumns + `
+from example.services
+where service_name in (
+    select service_name
+    from example.service_instances
+    where lease_expires_at < now()
+)
+order by service_name
+for update;`
+
+	expiredQuery := `
+select ` + instanceColumns + `
+from example.service_instances i
+where i.service_name = any($1)
+  and i.lease_expires_at < now()
+order by i.service_name, i.instance_id
+for update of i;`
+
+	query := `
+delete from example.service_instances
+where service_name = $1
+  and instance_id = $2;`
+
+	span.SetAttributes(attribute.String("query", expiredQuery))
+
+	var expired []*Instance
+
+	err := pgx.BeginFunc(ctx, p.pool, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, lockServicesQuery)
+		if err != nil {
+			return err
+		}
+
+		services, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*Service, error) {
+			return scanService(row)
+		})
+		if err != nil || len(services) == 0 {
+			return err
+		}
+
+		var (
+			byName       = make(map[string]*Service, len(services))
+			serviceNames = make([]string, 0, len(services))
+		)
+
+		for _, service := range services {
+			byName[service.Name] = service
+			serviceNames = append(serviceNames, service.Name)
+		}
+
+		rows, err = tx.Query(ctx, expiredQuery, serviceNames)
+		if err != nil {
+			return err
+		}
+
+		expired, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (*Instance, error) {
+			return scanInstance(row)
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, instance := range expired {
+			if _, err = tx.Exec(ctx, query, instance.ServiceName, instance.ID); err != nil {
+				return err
+			}
+
+			if err = recordInstanceChange(
+				ctx, tx, ChangeTypeInstanceRemoved, byName[instance.ServiceName], instance,
+			); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, fmt.Errorf("failed to delete expired instances | %w", err)
+	}
+
+	return expired, nil
+}
+
+// lockInstance reads an instance and locks its row until the end of tx.
+func lockInstance(ctx context.Context, tx pgx.Tx, serviceName, instanceID string) (*Instance, error) {
+	query := `
+select ` + instanceColumns + `
+from example.service_instances i
+where i.service_name = $1
+  and i.instance_id = $2
+for update of i;`
+
+	return scanInstance(tx.QueryRow(ctx, query, serviceName, instanceID))
+}
+
+func getInstance(ctx context.Context, tx pgx.Tx, serviceName, instanceID string) (*Instance, error) {
+	query := `
+select ` + instanceColumns + `
+from example.service_instances i
+where i.service_name = $1
+  and i.instance_id = $2;`
+
+	return scanInstance(tx.QueryRow(ctx, query, serviceName, instanceID))
+}
+
+func scanInstance(row pgx.Row) (*Instance, error) {
+	var (
+		instance        Instance
+		leaseTTLSeconds int64
+	)
+
+	if err := row.Scan(
+		&instance.ServiceName,
+		&instance.ID,
+		&leaseTTLSeconds,
+		&instance.LeaseExpiresAt,
+		&instance.RegisteredAt,
+		&instance.Endpoints,
+	); err != nil {
+		return nil, err
+	}
+
+	instance.LeaseTTL = time.Duration(leaseTTLSeconds) * time.Second
+
+	return &instance, nil
+}
@@ -0,0 +1,51 @@
+package example
+
+type LabelOperator int
+
+const (
+	LabelOperatorEquals LabelOperator = iota + 1
+	LabelOperatorNotEquals
+	LabelOperatorIn
+	LabelOperatorNotIn
+	LabelOperatorExists
+	LabelOperatorDoesNotExist
+)
+
+// LabelRequirement is a condition on the labels of a service, ListServicesFilter combines them with and.
+type LabelRequirement struct {
+	Key      string
+	Operator LabelOperator
+	Values   []string
+}
+
+// labelCondition compiles a requirement to a condition on the labels column. Keys and values are passed
+// as parameters only. Containment and existence checks are kept in the form the gin index on labels serves.
+func labelCondition(requirement *LabelRequirement, placeholder func(arg any) string) string {
+	switch requirement.Operator {
+	case LabelOperatorEquals:
+		return "labels @> " + placeholder(labelsOf(requirement)) + "::jsonb"
+	case LabelOperatorNotEquals:
+		return "not labels @> " + placeholder(labelsOf(requirement)) + "::jsonb"
+	case LabelOperatorIn:
+		return "labels ->> " + placeholder(requirement.Key) + " = any(" + placeholder(requirement.Values) + "::text[])"
+	case LabelOperatorNotIn:
+		return "coalesce(labels ->> " + placeholder(requirement.Key) +
+			" <> all(" + placeholder(requirement.Values) + "::text[]), true)"
+	case LabelOperatorExists:
+		return "labels ? " + placeholder(requirement.Key)
+	case LabelOperatorDoesNotExist:
+		return "not labels ? " + placeholder(requirement.Key)
+	default:
+		// an unknown operator must not widen the result
+		return "false"
+	}
+}
+
+func labelsOf(requirement *LabelRequirement) map[string]string {
+	value := ""
+	if len(requirement.Values) > 0 {
+		value = requirement.Values[0]
+	}
+
+	return map[string]string{requirement.Key: value}
+}
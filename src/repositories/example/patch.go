@@ -0,0 +1,229 @@
+package example
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Paths of an update mask, named after the fields of the API so a mask can be passed through unchanged.
+const (
+	PatchFieldServiceName = "ServiceName"
+	PatchFieldDescription = "Description"
+	PatchFieldOwnerTeam   = "OwnerTeam"
+	PatchFieldVersion     = "Version"
+	PatchFieldEndpoints   = "Endpoints"
+	PatchFieldLabels      = "Labels"
+	// PatchFieldProbe replaces the probe as a whole, paths of its subfields select it too.
+	PatchFieldProbe = "Probe"
+	// PatchFieldDependsOn is stored in example.service_dependencies rather than in a column.
+	PatchFieldDependsOn = "DependsOn"
+)
+
+// ErrInvalidPatch rejects an update mask without paths or with a path PatchService does not know.
+var ErrInvalidPatch = errors.New("invalid patch")
+
+// patchFields lists every path PatchService accepts, UpdateService patches all of them.
+var patchFields = []string{
+	PatchFieldServiceName, PatchFieldDescription, PatchFieldOwnerTeam, PatchFieldVersion,
+	PatchFieldEndpoints, PatchFieldLabels, PatchFieldProbe, PatchFieldDependsOn,
+}
+
+// PatchFields returns every path PatchService accepts.
+func PatchFields() []string {
+	return slices.Clone(patchFields)
+}
+
+// PatchFieldOf resolves a path of an update mask to its field, paths of the subfields of Probe select Probe.
+func PatchFieldOf(path string) (string, bool) {
+	field := path
+	if head, _, nested := strings.Cut(path, "."); nested && head == PatchFieldProbe {
+		field = head
+	}
+
+	return field, slices.Contains(patchFields, field)
+}
+
+// PatchService updates the fields of a service named by paths and leaves the rest as they are.
+// Renames, dependencies and probe changes are handled as in UpdateService. A non-zero expectedVersion
+// has to match the stored resource version.
+func (p *Postgres) PatchService(
+	ctx context.Context, serviceName string, service *Service, paths []string, expectedVersion int64,
+) (*Service, error) {
+	ctx, span := otel.Tracer(packageName).Start(ctx, "PatchService")
+	defer span.End()
+
+	updated, err := p.patchService(ctx, serviceName, service, paths, expectedVersion)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (p *Postgres) patchService(
+	ctx context.Context, serviceName string, service *Service, paths []string, expectedVersion int64,
+) (*Service, error) {
+	fields, err := patchFieldsOf(paths)
+	if err != nil {
+		return nil, err
+	}
+
+	query, args := patchServiceQuery(serviceName, service, fields)
+
+	trace.SpanFromContext(ctx).SetAttributes(attribute.String("query", query))
+
+	var updated *Service
+
+	err = pgx.BeginFunc(ctx, p.pool, func(tx pgx.Tx) error {
+		previous, txErr := lockService(ctx, tx, serviceName)
+		if txErr != nil {
+			return txErr
+		}
+
+		if txErr = checkResourceVersion(previous, expectedVersion); txErr != nil {
+			return txErr
+		}
+
+		updated, txErr = scanService(tx.QueryRow(ctx, query, args...))
+		if txErr != nil {
+			return txErr
+		}
+
+		if slices.Contains(fields, PatchFieldDependsOn) {
+			if txErr = setDependencies(ctx, tx, updated.Name, service.DependsOn); txErr != nil {
+				return txErr
+			}
+
+			updated.DependsOn = sortedDependencies(service.DependsOn)
+		} else {
+			// returning reads dependencies from the snapshot taken before a rename cascaded to them
+			updated.DependsOn = previous.DependsOn
+		}
+
+		// results of the previous probe say nothing about the new one
+		if !sameProbe(previous.Probe, updated.Probe) {
+			if txErr = resetHealth(ctx, tx, updated.Name); txErr != nil {
+				return txErr
+			}
+		}
+
+		return recordUpdate(ctx, tx, previous, updated)
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			return nil, ErrNotFound
+		case isUniqueViolation(err):
+			return nil, ErrAlreadyExists
+		default:
+			return nil, fmt.Errorf("failed to update service | %w", err)
+		}
+	}
+
+	return updated, nil
+}
+
+// recordUpdate records an update in the change log and the audit trail. A rename is recorded
+// as the removal of the old name followed by the addition of the new one, so watchers keyed by name stay consistent.
+func recordUpdate(ctx context.Context, tx pgx.Tx, previous, updated *Service) error {
+	if updated.Name == previous.Name {
+		if err := recordChange(ctx, tx, ChangeTypeUpdated, updated); err != nil {
+			return err
+		}
+
+		return recordEvent(ctx, tx, updated.Name, EventTypeUpdated, previous, updated)
+	}
+
+	if err := recordChange(ctx, tx, ChangeTypeRemoved, previous); err != nil {
+		return err
+	}
+
+	if err := recordChange(ctx, tx, ChangeTypeAdded, updated); err != nil {
+		return err
+	}
+
+	// the history of either name has to tell where the service went or came from
+	if err := recordEvent(ctx, tx, previous.Name, EventTypeRenamed, previous, updated); err != nil {
+		return err
+	}
+
+	return recordEvent(ctx, tx, updated.Name, EventTypeRenamed, previous, updated)
+}
+
+// patchFieldsOf resolves the paths of an update mask to fields, dropping duplicates.
+func patchFieldsOf(paths []string) ([]string, error) {
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("%w: no paths", ErrInvalidPatch)
+	}
+
+	var fields []string
+
+	for _, path := range paths {
+		field, ok := PatchFieldOf(path)
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown path %q", ErrInvalidPatch, path)
+		}
+
+		if !slices.Contains(fields, field) {
+			fields = append(fields, field)
+		}
+	}
+
+	return fields, nil
+}
+
+// patchServiceQuery sets the columns of fields only. Every patch bumps updated_at and resource_version,
+// including one of DependsOn alone, which has no column of its own.
+func patchServiceQuery(serviceName string, service *Service, fields []string) (string, []any) {
+	var (
+		assignments []string
+		args        []any
+	)
+
+	placeholder := func(arg any) string {
+		args = append(args, arg)
+		return "$" + strconv.Itoa(len(args))
+	}
+
+	where := "service_name = " + placeholder(serviceName)
+
+	for _, field := range fields {
+		switch field {
+		case PatchFieldServiceName:
+			assignments = append(assignments, "service_name = "+placeholder(service.Name))
+		case PatchFieldDescription:
+			assignments = append(assignments, "description = "+placeholder(service.Description))
+		case PatchFieldOwnerTeam:
+			assignments = append(assignments, "owner_team = "+placeholder(service.OwnerTeam))
+		case PatchFieldVersion:
+			assignments = append(assignments, "version = "+placeholder(service.Version))
+		case PatchFieldEndpoints:
+			assignments = append(assignments, "endpoints = "+placeholder(endpointsOrEmpty(service.Endpoints)))
+		case PatchFieldLabels:
+			assignments = append(assignments, "labels = "+placeholder(labelsOrEmpty(service.Labels)))
+		case PatchFieldProbe:
+			assignments = append(assignments, "probe = "+placeholder(service.Probe))
+		}
+	}
+
+	assignments = append(assignments, "updated_at = now()", "resource_version = resource_version + 1")
+
+	query := `
+update example.services
+set ` + strings.Join(assignments, ",\n    ") + `
+where ` + where + `
+returning ` + serviceColumns + `;`
+
+	return query, args
+}
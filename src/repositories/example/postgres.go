@@ -4,16 +4,114 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/puddle/v2"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
 )
 
-const packageName = "example"
+const (
+	packageName = "example"
 
-var ErrNotFound = errors.New("not found")
+	uniqueViolationCode = "23505"
+
+	// connectionExceptionClass and the codes below are errors of the server, not of the query
+	connectionExceptionClass = "08"
+	tooManyConnectionsCode   = "53300"
+	adminShutdownCode        = "57P01"
+	crashShutdownCode        = "57P02"
+	cannotConnectNowCode     = "57P03"
+)
+
+var (
+	ErrNotFound      = errors.New("not found")
+	ErrAlreadyExists = errors.New("already exists")
+)
+
+// serviceColumns is the column list scanned by scanService.
+const serviceColumns = `service_name, description, owner_team, version, endpoints, labels, created_at, updated_at,
+       lease_ttl_seconds, lease_expires_at, expired_at, probe, resource_version,
+       array(
+           select d.depends_on
+           from example.service_dependencies d
+           where d.service_name = services.service_name
+           order by d.depends_on
+       )`
+
+// Service is a row of example.services. The json tags define the snapshot stored in example.service_changes.
+type Service struct {
+	Name        string            `json:"service_name"`
+	Description string            `json:"description"`
+	OwnerTeam   string            `json:"owner_team"`
+	Version     string            `json:"version"`
+	Endpoints   []Endpoint        `json:"endpoints"`
+	Labels      map[string]string `json:"labels"`
+	CreatedAt   time.Time         `json:"created_at"`
+	UpdatedAt   time.Time         `json:"updated_at"`
+	// LeaseTTL is zero for registrations that never expire.
+	LeaseTTL       time.Duration `json:"lease_ttl"`
+	LeaseExpiresAt *time.Time    `json:"lease_expires_at"`
+	ExpiredAt      *time.Time    `json:"expired_at"`
+	// Probe is nil for services that are not probed.
+	Probe *Endpoint `json:"probe"`
+	// DependsOn holds the names of the services this one depends on, sorted.
+	DependsOn []string `json:"depends_on"`
+	// ResourceVersion starts at 1 and grows with every UpdateService. Heartbeats and lease expiry
+	// leave it as is, they change the state of the lease and not what clients have written.
+	ResourceVersion int64 `json:"resource_version"`
+}
+
+// VersionMismatchError rejects a write that expected another resource version than the stored one.
+type VersionMismatchError struct {
+	Expected int64
+	Actual   int64
+}
+
+func (e *VersionMismatchError) Error() string {
+	return fmt.Sprintf("expected resource version %d, current is %d", e.Expected, e.Actual)
+}
+
+// Endpoint is stored as an element of the endpoints jsonb array, and as the probe column.
+type Endpoint struct {
+	Protocol string `json:"protocol"`
+	Address  string `json:"address"`
+}
+
+type SortField int
+
+const (
+	SortFieldServiceName SortField = iota
+)
+
+// ServiceCursor is the position of the last row of the previous page.
+type ServiceCursor struct {
+	Name string
+}
+
+type ListServicesFilter struct {
+	NamePrefix string
+	// Labels are requirements a service has to meet all of.
+	Labels     []*LabelRequirement
+	SortField  SortField
+	Descending bool
+	// After is nil for the first page.
+	After *ServiceCursor
+	Limit int
+}
+
+var sortColumns = map[SortField]string{
+	SortFieldServiceName: "service_name",
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
 
 type Postgres struct {
 	pool *pgxpool.Pool
@@ -50,13 +148,31 @@ func (p *Postgres) RegisterService(ctx context.Context, serviceName string) erro
 	defer span.End()
 
 	query := `
-insert into example.services
+insert into example.services (service_name)
 values ($1)
-on conflict (service_name) do nothing;`
+on conflict (service_name) do nothing
+returning ` + serviceColumns + `;`
 
 	span.SetAttributes(attribute.String("query", query))
 
-	if _, err := p.pool.Exec(ctx, query, serviceName); err != nil {
+	err := pgx.BeginFunc(ctx, p.pool, func(tx pgx.Tx) error {
+		created, err := scanService(tx.QueryRow(ctx, query, serviceName))
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				// already registered
+				return nil
+			}
+
+			return err
+		}
+
+		if err = recordChange(ctx, tx, ChangeTypeAdded, created); err != nil {
+			return err
+		}
+
+		return recordEvent(ctx, tx, created.Name, EventTypeRegistered, nil, created)
+	})
+	if err != nil {
 		span.SetStatus(codes.Error, err.Error())
 		return fmt.Errorf("failed to insert service | %w", err)
 	}
@@ -87,3 +203,548 @@ select exists (
 
 	return exists, nil
 }
+
+// insertServiceQuery starts the lease of a service with a ttl right away.
+const insertServiceQuery = `
+insert into example.services (
+    service_name, owner_team, version, endpoints, labels, lease_ttl_seconds, lease_expires_at, probe, description
+)
+values (
+    $1, $2, $3, $4, $5, $6::bigint,
+    case when $6::bigint > 0 then now() + $6::bigint * interval '1 second' end,
+    $7, $8
+)
+returning ` + serviceColumns + `;`
+
+func (p *Postgres) CreateService(ctx context.Context, service *Service) (*Service, error) {
+	ctx, span := otel.Tracer(packageName).Start(ctx, "CreateService")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("query", insertServiceQuery))
+
+	var created *Service
+
+	err := pgx.BeginFunc(ctx, p.pool, func(tx pgx.Tx) error {
+		var txErr error
+
+		created, txErr = insertService(ctx, tx, service)
+		if txErr != nil {
+			return txErr
+		}
+
+		if txErr = setDependencies(ctx, tx, created.Name, service.DependsOn); txErr != nil {
+			return txErr
+		}
+
+		created.DependsOn = sortedDependencies(service.DependsOn)
+
+		if txErr = recordChange(ctx, tx, ChangeTypeAdded, created); txErr != nil {
+			return txErr
+		}
+
+		return recordEvent(ctx, tx, created.Name, EventTypeRegistered, nil, created)
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+
+		if isUniqueViolation(err) {
+			return nil, ErrAlreadyExists
+		}
+
+		return nil, fmt.Errorf("failed to insert service | %w", err)
+	}
+
+	return created, nil
+}
+
+func (p *Postgres) GetService(ctx context.Context, serviceName string) (*Service, error) {
+	ctx, span := otel.Tracer(packageName).Start(ctx, "GetService")
+	defer span.End()
+
+	query := `
+select ` + serviceColumns + `
+from example.services
+where service_name = $1;`
+
+	span.SetAttributes(attribute.String("query", query))
+
+	row := p.pool.QueryRow(ctx, query, serviceName)
+
+	service, err := scanService(row)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+
+		return nil, fmt.Errorf("cannot get service | %w", err)
+	}
+
+	return service, nil
+}
+
+// UpdateService replaces the metadata of a service, it is PatchService with every field in the mask.
+// A non-zero expectedVersion has to match the stored resource version, see checkResourceVersion.
+func (p *Postgres) UpdateService(
+	ctx context.Context, serviceName string, service *Service, expectedVersion int64,
+) (*Service, error) {
+	ctx, span := otel.Tracer(packageName).Start(ctx, "UpdateService")
+	defer span.End()
+
+	updated, err := p.patchService(ctx, serviceName, service, patchFields, expectedVersion)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// Heartbeat renews the lease of a service. An expired registration that has not been deleted yet becomes active again.
+// Plain renewals are not recorded as changes, otherwise every heartbeat would wake up all watchers.
+func (p *Postgres) Heartbeat(ctx context.Context, serviceName string) (*Service, error) {
+	ctx, span := otel.Tracer(packageName).Start(ctx, "Heartbeat")
+	defer span.End()
+
+	query := `
+update example.services
+set lease_expires_at = case
+        when lease_ttl_seconds > 0 then now() + lease_ttl_seconds * interval '1 second'
+    end,
+    expired_at       = null
+where service_name = $1
+returning ` + serviceColumns + `;`
+
+	span.SetAttributes(attribute.String("query", query))
+
+	var service *Service
+
+	err := pgx.BeginFunc(ctx, p.pool, func(tx pgx.Tx) error {
+		previous, txErr := lockService(ctx, tx, serviceName)
+		if txErr != nil {
+			return txErr
+		}
+
+		service, txErr = scanService(tx.QueryRow(ctx, query, serviceName))
+		if txErr != nil {
+			return txErr
+		}
+
+		if previous.ExpiredAt == nil {
+			return nil
+		}
+
+		if txErr = recordChange(ctx, tx, ChangeTypeUpdated, service); txErr != nil {
+			return txErr
+		}
+
+		return recordEvent(ctx, tx, service.Name, EventTypeRevived, previous, service)
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+
+		return nil, fmt.Errorf("failed to renew lease | %w", err)
+	}
+
+	return service, nil
+}
+
+// ExpireLeases marks services whose lease has run out as expired and returns their names.
+func (p *Postgres) ExpireLeases(ctx context.Context) ([]string, error) {
+	ctx, span := otel.Tracer(packageName).Start(ctx, "ExpireLeases")
+	defer span.End()
+
+	query := `
+update example.services
+set expired_at = now()
+where expired_at is null
+  and lease_expires_at < now()
+returning ` + serviceColumns + `;`
+
+	span.SetAttributes(attribute.String("query", query))
+
+	serviceNames, err := p.mutateServices(ctx, query, func(tx pgx.Tx, service *Service) error {
+		if err := recordChange(ctx, tx, ChangeTypeUpdated, service); err != nil {
+			return err
+		}
+
+		// nothing but expired_at has changed
+		previous := *service
+		previous.ExpiredAt = nil
+
+		return recordEvent(ctx, tx, service.Name, EventTypeExpired, &previous, service)
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, fmt.Errorf("failed to expire leases | %w", err)
+	}
+
+	return serviceNames, nil
+}
+
+// DeleteExpiredServices deletes services that have been expired for longer than retention and returns their names.
+// Services depending on them lose these dependencies, as in DeleteService.
+func (p *Postgres) DeleteExpiredServices(ctx context.Context, retention time.Duration) ([]string, error) {
+	ctx, span := otel.Tracer(packageName).Start(ctx, "DeleteExpiredServices")
+	defer span.End()
+
+	lockQuery := `
+select service_name
+from example.services
+where expired_at < now() - $1::double precision * interval '1 second'
+order by service_name
+for update;`
+
+	query := `
+delete from example.services
+where service_name = any($1)
+returning ` + serviceColumns + `;`
+
+	span.SetAttributes(attribute.String("query", query))
+
+	var serviceNames []string
+
+	err := pgx.BeginFunc(ctx, p.pool, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, lockQuery, retention.Seconds())
+		if err != nil {
+			return err
+		}
+
+		expired, err := pgx.CollectRows(rows, pgx.RowTo[string])
+		if err != nil || len(expired) == 0 {
+			return err
+		}
+
+		if err = releaseDependents(ctx, tx, expired); err != nil {
+			return err
+		}
+
+		rows, err = tx.Query(ctx, query, expired)
+		if err != nil {
+			return err
+		}
+
+		services, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*Service, error) {
+			return scanService(row)
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, service := range services {
+			if err = recordChange(ctx, tx, ChangeTypeRemoved, service); err != nil {
+				return err
+			}
+
+			if err = recordEvent(ctx, tx, service.Name, EventTypeDeleted, service, nil); err != nil {
+				return err
+			}
+
+			serviceNames = append(serviceNames, service.Name)
+		}
+
+		return nil
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, fmt.Errorf("failed to delete expired services | %w", err)
+	}
+
+	return serviceNames, nil
+}
+
+// mutateServices runs a statement returning serviceColumns, calls record for every affected row
+// inside the same transaction and returns the names of the affected services.
+func (p *Postgres) mutateServices(
+	ctx context.Context, query string, record func(tx pgx.Tx, service *Service) error, args ...any,
+) ([]string, error) {
+	var serviceNames []string
+
+	err := pgx.BeginFunc(ctx, p.pool, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, query, args...)
+		if err != nil {
+			return err
+		}
+
+		services, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*Service, error) {
+			return scanService(row)
+		})
+		if err != nil {
+			return err
+		}
+
+		serviceNames = make([]string, 0, len(services))
+		for _, service := range services {
+			if err = record(tx, service); err != nil {
+				return err
+			}
+
+			serviceNames = append(serviceNames, service.Name)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return serviceNames, nil
+}
+
+// DeleteService deletes a service. A non-zero expectedVersion has to match the stored resource version.
+func (p *Postgres) DeleteService(ctx context.Context, serviceName string, expectedVersion int64) error {
+	ctx, span := otel.Tracer(packageName).Start(ctx, "DeleteService")
+	defer span.End()
+
+	query := `
+delete from example.services
+where service_name = $1
+returning ` + serviceColumns + `;`
+
+	span.SetAttributes(attribute.String("query", query))
+
+	err := pgx.BeginFunc(ctx, p.pool, func(tx pgx.Tx) error {
+		previous, err := lockService(ctx, tx, serviceName)
+		if err != nil {
+			return err
+		}
+
+		if err = checkResourceVersion(previous, expectedVersion); err != nil {
+			return err
+		}
+
+		if err = releaseDependents(ctx, tx, []string{serviceName}); err != nil {
+			return err
+		}
+
+		deleted, err := scanService(tx.QueryRow(ctx, query, serviceName))
+		if err != nil {
+			return err
+		}
+
+		if err = recordChange(ctx, tx, ChangeTypeRemoved, deleted); err != nil {
+			return err
+		}
+
+		return recordEvent(ctx, tx, deleted.Name, EventTypeUnregistered, deleted, nil)
+	})
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+
+		return fmt.Errorf("failed to delete service | %w", err)
+	}
+
+	return nil
+}
+
+// ListServices returns services using keyset pagination: the next page starts strictly after filter.After
+// in the requested order, so pages stay consistent while rows are inserted or deleted concurrently.
+func (p *Postgres) ListServices(ctx context.Context, filter *ListServicesFilter) ([]*Service, error) {
+	ctx, span := otel.Tracer(packageName).Start(ctx, "ListServices")
+	defer span.End()
+
+	query, args := listServicesQuery(filter)
+
+	span.SetAttributes(attribute.String("query", query))
+
+	rows, err := p.pool.Query(ctx, query, args...)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, fmt.Errorf("cannot list services | %w", err)
+	}
+	defer rows.Close()
+
+	services := make([]*Service, 0, filter.Limit)
+	for rows.Next() {
+		service, scanErr := scanService(rows)
+		if scanErr != nil {
+			span.SetStatus(codes.Error, scanErr.Error())
+			return nil, fmt.Errorf("cannot scan service | %w", scanErr)
+		}
+
+		services = append(services, service)
+	}
+
+	if err = rows.Err(); err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, fmt.Errorf("cannot list services | %w", err)
+	}
+
+	return services, nil
+}
+
+func listServicesQuery(filter *ListServicesFilter) (string, []any) {
+	var (
+		conditions []string
+		args       []any
+	)
+
+	placeholder := func(arg any) string {
+		args = append(args, arg)
+		return "$" + strconv.Itoa(len(args))
+	}
+
+	if filter.NamePrefix != "" {
+		conditions = append(conditions,
+			"service_name like "+placeholder(likeEscaper.Replace(filter.NamePrefix)+"%"))
+	}
+
+	for _, requirement := range filter.Labels {
+		conditions = append(conditions, labelCondition(requirement, placeholder))
+	}
+
+	column := sortColumns[filter.SortField]
+
+	comparison, direction := ">", "asc"
+	if filter.Descending {
+		comparison, direction = "<", "desc"
+	}
+
+	if filter.After != nil {
+		conditions = append(conditions, column+" "+comparison+" "+placeholder(filter.After.Name))
+	}
+
+	query := `
+select ` + serviceColumns + `
+from example.services`
+
+	if len(conditions) > 0 {
+		query += "\nwhere " + strings.Join(conditions, "\n  and ")
+	}
+
+	query += "\norder by " + column + " " + direction +
+		"\nlimit " + placeholder(filter.Limit) + ";"
+
+	return query, args
+}
+
+// insertService inserts a service without its dependencies, they are set by setDependencies.
+func insertService(ctx context.Context, tx pgx.Tx, service *Service) (*Service, error) {
+	return scanService(tx.QueryRow(ctx, insertServiceQuery,
+		service.Name, service.OwnerTeam, service.Version,
+		endpointsOrEmpty(service.Endpoints), labelsOrEmpty(service.Labels),
+		int64(service.LeaseTTL.Seconds()), service.Probe, service.Description,
+	))
+}
+
+// lockService reads a service and locks its row until the end of tx.
+func lockService(ctx context.Context, tx pgx.Tx, serviceName string) (*Service, error) {
+	query := `
+select ` + serviceColumns + `
+from example.services
+where service_name = $1
+for update;`
+
+	return scanService(tx.QueryRow(ctx, query, serviceName))
+}
+
+func scanService(row pgx.Row) (*Service, error) {
+	var (
+		service         Service
+		leaseTTLSeconds int64
+	)
+
+	if err := row.Scan(
+		&service.Name,
+		&service.Description,
+		&service.OwnerTeam,
+		&service.Version,
+		&service.Endpoints,
+		&service.Labels,
+		&service.CreatedAt,
+		&service.UpdatedAt,
+		&leaseTTLSeconds,
+		&service.LeaseExpiresAt,
+		&service.ExpiredAt,
+		&service.Probe,
+		&service.ResourceVersion,
+		&service.DependsOn,
+	); err != nil {
+		return nil, err
+	}
+
+	service.LeaseTTL = time.Duration(leaseTTLSeconds) * time.Second
+
+	return &service, nil
+}
+
+// checkResourceVersion compares versions of a row locked by lockService, so nothing can change it
+// between the check and the write. Zero expectedVersion skips the check.
+func checkResourceVersion(service *Service, expectedVersion int64) error {
+	if expectedVersion != 0 && service.ResourceVersion != expectedVersion {
+		return &VersionMismatchError{Expected: expectedVersion, Actual: service.ResourceVersion}
+	}
+
+	return nil
+}
+
+// sortedDependencies returns dependencies in the order they are read back from the database.
+func sortedDependencies(dependsOn []string) []string {
+	sorted := slices.Clone(dependsOn)
+	if sorted == nil {
+		sorted = []string{}
+	}
+
+	slices.Sort(sorted)
+
+	return sorted
+}
+
+// endpointsOrEmpty keeps nil slices from being stored as a json null.
+func endpointsOrEmpty(endpoints []Endpoint) []Endpoint {
+	if endpoints == nil {
+		return []Endpoint{}
+	}
+
+	return endpoints
+}
+
+// labelsOrEmpty keeps nil maps from being stored as a json null.
+func labelsOrEmpty(labels map[string]string) map[string]string {
+	if labels == nil {
+		return map[string]string{}
+	}
+
+	return labels
+}
+
+// IsUnavailable reports whether err is of an unreachable, overloaded or restarting database rather than
+// of the query, so that retrying it later may succeed. Canceled and timed out calls are not.
+func IsUnavailable(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) || pgconn.Timeout(err) {
+		return false
+	}
+
+	var (
+		connectErr *pgconn.ConnectError
+		pgErr      *pgconn.PgError
+	)
+
+	switch {
+	case errors.As(err, &connectErr), errors.Is(err, puddle.ErrClosedPool), pgconn.SafeToRetry(err):
+		return true
+	case errors.As(err, &pgErr):
+		switch pgErr.Code {
+		case tooManyConnectionsCode, adminShutdownCode, crashShutdownCode, cannotConnectNowCode:
+			return true
+		}
+
+		return strings.HasPrefix(pgErr.Code, connectionExceptionClass)
+	default:
+		return false
+	}
+}
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode
+}
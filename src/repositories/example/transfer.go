@@ -0,0 +1,305 @@
+package example
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+type ImportMode int
+
+const (
+	// ImportModeFail rejects the whole import if a service is registered with different metadata.
+	ImportModeFail ImportMode = iota
+	// ImportModeSkip keeps registered services as they are.
+	ImportModeSkip
+	// ImportModeUpsert replaces registered services with the imported ones.
+	ImportModeUpsert
+)
+
+type ImportAction int
+
+const (
+	ImportActionCreate ImportAction = iota + 1
+	ImportActionUpdate
+	// ImportActionUnchanged means the service is registered exactly as imported.
+	ImportActionUnchanged
+	ImportActionSkip
+)
+
+// PatchFieldTTL names the lease ttl in the changed fields of an import, it has no patch path of its own.
+const PatchFieldTTL = "Ttl"
+
+// ImportDiff tells what an import does to a single service.
+type ImportDiff struct {
+	ServiceName string
+	Action      ImportAction
+	// ChangedFields are patch paths of the fields that differ from the registered service.
+	ChangedFields []string
+}
+
+type ImportResult struct {
+	Diff []*ImportDiff
+	// EventsImported does not count the events the history already had.
+	EventsImported int
+}
+
+// ImportConflictError rejects an import in ImportModeFail, Names are the conflicting services.
+type ImportConflictError struct {
+	Names []string
+}
+
+func (e *ImportConflictError) Error() string {
+	return "registered with different metadata: " + strings.Join(e.Names, ", ")
+}
+
+// errDryRun rolls back the transaction of a dry run.
+var errDryRun = errors.New("dry run")
+
+// importWrite is a service written by an import, its dependencies are set once every service is in place.
+type importWrite struct {
+	previous  *Service
+	service   *Service
+	dependsOn []string
+}
+
+// ImportServices writes services and appends events in a single transaction. Dependencies are set after
+// every service is written, so a service may depend on one imported after it. Writes are recorded
+// in the change log and the audit trail like any other. A dry run does the same and rolls back,
+// so the result reports exactly what the import would do.
+func (p *Postgres) ImportServices(
+	ctx context.Context, services []*Service, events []*Event, mode ImportMode, dryRun bool,
+) (*ImportResult, error) {
+	ctx, span := otel.Tracer(packageName).Start(ctx, "ImportServices")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("services", len(services)),
+		attribute.Int("events", len(events)),
+		attribute.Bool("dry_run", dryRun),
+	)
+
+	var result *ImportResult
+
+	err := pgx.BeginFunc(ctx, p.pool, func(tx pgx.Tx) error {
+		result = &ImportResult{
+			Diff:           make([]*ImportDiff, 0, len(services)),
+			EventsImported: 0,
+		}
+
+		writes, txErr := importServices(ctx, tx, services, mode, result)
+		if txErr != nil {
+			return txErr
+		}
+
+		// dependencies go last, a service may depend on one that comes later in the import
+		for _, write := range writes {
+			if txErr = recordImportWrite(ctx, tx, write); txErr != nil {
+				return txErr
+			}
+		}
+
+		for _, event := range events {
+			inserted, insertErr := insertEvent(ctx, tx, event)
+			if insertErr != nil {
+				return insertErr
+			}
+
+			if inserted {
+				result.EventsImported++
+			}
+		}
+
+		if dryRun {
+			return errDryRun
+		}
+
+		return nil
+	})
+	if err != nil && !errors.Is(err, errDryRun) {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, fmt.Errorf("failed to import services | %w", err)
+	}
+
+	return result, nil
+}
+
+func importServices(
+	ctx context.Context, tx pgx.Tx, services []*Service, mode ImportMode, result *ImportResult,
+) ([]*importWrite, error) {
+	var (
+		writes    []*importWrite
+		conflicts []string
+	)
+
+	for _, service := range services {
+		previous, err := lockService(ctx, tx, service.Name)
+		if errors.Is(err, pgx.ErrNoRows) {
+			created, insertErr := insertService(ctx, tx, service)
+			if insertErr != nil {
+				return nil, insertErr
+			}
+
+			writes = append(writes, &importWrite{previous: nil, service: created, dependsOn: service.DependsOn})
+			result.Diff = append(result.Diff, &ImportDiff{
+				ServiceName: service.Name, Action: ImportActionCreate, ChangedFields: nil,
+			})
+
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		diff := &ImportDiff{
+			ServiceName:   service.Name,
+			Action:        ImportActionUpdate,
+			ChangedFields: changedFields(previous, service),
+		}
+		result.Diff = append(result.Diff, diff)
+
+		switch {
+		case len(diff.ChangedFields) == 0:
+			diff.Action = ImportActionUnchanged
+		case mode == ImportModeFail:
+			conflicts = append(conflicts, service.Name)
+		case mode == ImportModeSkip:
+			diff.Action = ImportActionSkip
+		default:
+			replaced, replaceErr := replaceService(ctx, tx, service)
+			if replaceErr != nil {
+				return nil, replaceErr
+			}
+
+			writes = append(writes, &importWrite{previous: previous, service: replaced, dependsOn: service.DependsOn})
+		}
+	}
+
+	if len(conflicts) > 0 {
+		return nil, &ImportConflictError{Names: conflicts}
+	}
+
+	return writes, nil
+}
+
+func recordImportWrite(ctx context.Context, tx pgx.Tx, write *importWrite) error {
+	if err := setDependencies(ctx, tx, write.service.Name, write.dependsOn); err != nil {
+		return err
+	}
+
+	write.service.DependsOn = sortedDependencies(write.dependsOn)
+
+	if write.previous == nil {
+		if err := recordChange(ctx, tx, ChangeTypeAdded, write.service); err != nil {
+			return err
+		}
+
+		return recordEvent(ctx, tx, write.service.Name, EventTypeRegistered, nil, write.service)
+	}
+
+	if !sameProbe(write.previous.Probe, write.service.Probe) {
+		if err := resetHealth(ctx, tx, write.service.Name); err != nil {
+			return err
+		}
+	}
+
+	return recordUpdate(ctx, tx, write.previous, write.service)
+}
+
+// replaceService overwrites every imported field of a registered service. Its lease starts over,
+// as it does for a new registration.
+func replaceService(ctx context.Context, tx pgx.Tx, service *Service) (*Service, error) {
+	query := `
+update example.services
+set description       = $2,
+    owner_team        = $3,
+    version           = $4,
+    endpoints         = $5,
+    labels            = $6,
+    probe             = $7,
+    lease_ttl_seconds = $8::bigint,
+    lease_expires_at  = case when $8::bigint > 0 then now() + $8::bigint * interval '1 second' end,
+    expired_at        = null,
+    updated_at        = now(),
+    resource_version  = resource_version + 1
+where service_name = $1
+returning ` + serviceColumns + `;`
+
+	return scanService(tx.QueryRow(ctx, query,
+		service.Name, service.Description, service.OwnerTeam, service.Version,
+		endpointsOrEmpty(service.Endpoints), labelsOrEmpty(service.Labels), service.Probe,
+		int64(service.LeaseTTL.Seconds()),
+	))
+}
+
+// insertEvent appends an imported event, keeping its actor and time. Its id is assigned anew. An event
+// the audit trail already has, the same type of change of the service by the same actor at the same time,
+// is skipped, so importing an export again does not duplicate its history. It reports whether it inserted.
+func insertEvent(ctx context.Context, tx pgx.Tx, event *Event) (bool, error) {
+	query := `
+insert into example.service_events (service_name, event_type, actor, old_value, new_value, occurred_at)
+select $1, $2, $3, $4, $5, $6
+where not exists (select 1
+                  from example.service_events
+                  where service_name = $1
+                    and event_type = $2
+                    and actor = $3
+                    and occurred_at = $6);`
+
+	tag, err := tx.Exec(ctx, query,
+		event.ServiceName, string(event.Type), event.Actor, event.OldValue, event.NewValue, event.OccurredAt,
+	)
+	if err != nil {
+		return false, fmt.Errorf("cannot import service event | %w", err)
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// changedFields compares what an import writes, timestamps and lease state are not compared.
+func changedFields(registered, imported *Service) []string {
+	var changed []string
+
+	if registered.Description != imported.Description {
+		changed = append(changed, PatchFieldDescription)
+	}
+
+	if registered.OwnerTeam != imported.OwnerTeam {
+		changed = append(changed, PatchFieldOwnerTeam)
+	}
+
+	if registered.Version != imported.Version {
+		changed = append(changed, PatchFieldVersion)
+	}
+
+	if !slices.Equal(registered.Endpoints, imported.Endpoints) {
+		changed = append(changed, PatchFieldEndpoints)
+	}
+
+	if !maps.Equal(registered.Labels, imported.Labels) {
+		changed = append(changed, PatchFieldLabels)
+	}
+
+	if !sameProbe(registered.Probe, imported.Probe) {
+		changed = append(changed, PatchFieldProbe)
+	}
+
+	if !slices.Equal(sortedDependencies(registered.DependsOn), sortedDependencies(imported.DependsOn)) {
+		changed = append(changed, PatchFieldDependsOn)
+	}
+
+	if registered.LeaseTTL != imported.LeaseTTL {
+		changed = append(changed, PatchFieldTTL)
+	}
+
+	return changed
+}
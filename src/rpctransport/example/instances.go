@@ -0,0 +1,96 @@
+package example
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	servergrpc "github.com/ingvarmattis/example/gen/servergrpc/example"
+	exampleSvc "github.com/ingvarmattis/example/src/services/example"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Handlers) RegisterInstance(
+	ctx context.Context, req *servergrpc.RegisterInstanceRequest,
+) (*servergrpc.RegisterInstanceResponse, error) {
+	instance, err := s.Service.ExampleService.RegisterInstance(ctx, &exampleSvc.Instance{
+		ServiceName:    req.GetServiceName(),
+		InstanceID:     req.GetInstanceId(),
+		Endpoints:      mapEndpointsToSvc(req.GetEndpoints()),
+		LeaseTTL:       req.GetTtl().AsDuration(),
+		LeaseExpiresAt: nil,
+		RegisteredAt:   time.Time{},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot register instance | %w", err)
+	}
+
+	return &servergrpc.RegisterInstanceResponse{Instance: mapInstance(instance)}, nil
+}
+
+func (s *Handlers) ListInstances(
+	ctx context.Context, req *servergrpc.ListInstancesRequest,
+) (*servergrpc.ListInstancesResponse, error) {
+	instances, err := s.Service.ExampleService.ListInstances(ctx, req.GetServiceName())
+	if err != nil {
+		return nil, fmt.Errorf("cannot list instances | %w", err)
+	}
+
+	resp := &servergrpc.ListInstancesResponse{
+		Instances: make([]*servergrpc.Instance, 0, len(instances)),
+	}
+
+	for _, instance := range instances {
+		resp.Instances = append(resp.Instances, mapInstance(instance))
+	}
+
+	return resp, nil
+}
+
+func (s *Handlers) InstanceHeartbeat(
+	ctx context.Context, req *servergrpc.InstanceHeartbeatRequest,
+) (*servergrpc.InstanceHeartbeatResponse, error) {
+	instance, err := s.Service.ExampleService.InstanceHeartbeat(ctx, req.GetServiceName(), req.GetInstanceId())
+	if err != nil {
+		return nil, fmt.Errorf("cannot renew instance lease | %w", err)
+	}
+
+	return &servergrpc.InstanceHeartbeatResponse{Instance: mapInstance(instance)}, nil
+}
+
+func (s *Handlers) UnregisterInstance(
+	ctx context.Context, req *servergrpc.UnregisterInstanceRequest,
+) (*emptypb.Empty, error) {
+	if err := s.Service.ExampleService.UnregisterInstance(
+		ctx, req.GetServiceName(), req.GetInstanceId(),
+	); err != nil {
+		return nil, fmt.Errorf("cannot unregister instance | %w", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func mapInstance(instance *exampleSvc.Instance) *servergrpc.Instance {
+	if instance == nil {
+		return nil
+	}
+
+	endpoints := make([]*servergrpc.Endpoint, 0, len(instance.Endpoints))
+	for _, endpoint := range instance.Endpoints {
+		endpoints = append(endpoints, &servergrpc.Endpoint{
+			Protocol: mapEndpointProtocol(endpoint.Protocol),
+			Address:  endpoint.Address,
+		})
+	}
+
+	return &servergrpc.Instance{
+		ServiceName:    instance.ServiceName,
+		InstanceId:     instance.InstanceID,
+		Endpoints:      endpoints,
+		Ttl:            durationpb.New(instance.LeaseTTL),
+		LeaseExpiresAt: mapOptionalTime(instance.LeaseExpiresAt),
+		RegisteredAt:   timestamppb.New(instance.RegisteredAt),
+	}
+}
@@ -2,13 +2,25 @@ package example
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strconv"
+	"time"
 
 	servergrpc "github.com/ingvarmattis/example/gen/servergrpc/example"
+	"github.com/ingvarmattis/example/src/etag"
 	"github.com/ingvarmattis/example/src/services"
+	exampleSvc "github.com/ingvarmattis/example/src/services/example"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// RevisionHeader is the WatchServices response header with the revision the stream starts after.
+const RevisionHeader = "x-registry-revision"
+
 type Handlers struct {
 	Service services.SvcLayer
 }
@@ -23,21 +35,502 @@ func (s *Handlers) ServiceName(ctx context.Context, _ *emptypb.Empty) (*servergr
 }
 
 func (s *Handlers) Status(ctx context.Context, req *servergrpc.StatusRequest) (*servergrpc.StatusResponse, error) {
-	exists, err := s.Service.ExampleService.Exists(ctx, req.GetServiceName())
+	registration, err := s.Service.ExampleService.GetService(ctx, req.GetServiceName())
+
+	resp, err := mapStatus(registration, err)
+	if err != nil || resp.GetService().GetProbe() == nil {
+		return resp, err
+	}
+
+	health, err := s.Service.ExampleService.GetHealth(ctx, req.GetServiceName())
 	if err != nil {
-		return nil, fmt.Errorf("cannot get service | %w", err)
+		return nil, fmt.Errorf("cannot get health | %w", err)
+	}
+
+	resp.Health = mapHealth(health)
+
+	return resp, nil
+}
+
+func mapHealth(health *exampleSvc.Health) *servergrpc.ServiceHealth {
+	var status servergrpc.HealthStatus
+
+	switch health.Status {
+	case exampleSvc.HealthStatusUnknown:
+		return &servergrpc.ServiceHealth{Status: servergrpc.HealthStatus_HEALTH_STATUS_UNSPECIFIED}
+	case exampleSvc.HealthStatusHealthy:
+		status = servergrpc.HealthStatus_HEALTH_STATUS_HEALTHY
+	case exampleSvc.HealthStatusDegraded:
+		status = servergrpc.HealthStatus_HEALTH_STATUS_DEGRADED
+	case exampleSvc.HealthStatusUnhealthy:
+		status = servergrpc.HealthStatus_HEALTH_STATUS_UNHEALTHY
 	}
 
-	return mapStatus(exists, err)
+	return &servergrpc.ServiceHealth{
+		Status:              status,
+		LastProbeAt:         timestamppb.New(health.LastProbeAt),
+		LastProbeLatency:    durationpb.New(health.LastProbeLatency),
+		LastError:           health.LastError,
+		ConsecutiveFailures: int32(health.ConsecutiveFailures),
+	}
 }
 
-func mapStatus(exists bool, err error) (*servergrpc.StatusResponse, error) {
+func mapStatus(registration *exampleSvc.Registration, err error) (*servergrpc.StatusResponse, error) {
 	switch {
+	case errors.Is(err, exampleSvc.ErrNotFound):
+		return &servergrpc.StatusResponse{Status: servergrpc.Status_NOT_REGISTERED}, nil
 	case err != nil:
 		return nil, fmt.Errorf("cannot get status | %w", err)
-	case exists:
-		return &servergrpc.StatusResponse{Status: servergrpc.Status_REGISTERED}, nil
+	case registration.Expired():
+		return &servergrpc.StatusResponse{
+			Status:  servergrpc.Status_EXPIRED,
+			Service: mapService(registration),
+		}, nil
 	default:
-		return &servergrpc.StatusResponse{Status: servergrpc.Status_NOT_REGISTERED}, nil
+		return &servergrpc.StatusResponse{
+			Status:  servergrpc.Status_REGISTERED,
+			Service: mapService(registration),
+		}, nil
+	}
+}
+
+func (s *Handlers) RegisterService(
+	ctx context.Context, req *servergrpc.RegisterServiceRequest,
+) (*servergrpc.RegisterServiceResponse, error) {
+	registration, err := s.Service.ExampleService.RegisterService(ctx, &exampleSvc.Registration{
+		ServiceName: req.GetServiceName(),
+		Description: req.GetDescription(),
+		OwnerTeam:   req.GetOwnerTeam(),
+		Version:     req.GetVersion(),
+		Endpoints:   mapEndpointsToSvc(req.GetEndpoints()),
+		Labels:      req.GetLabels(),
+		LeaseTTL:    req.GetTtl().AsDuration(),
+		Probe:       mapProbeToSvc(req.GetProbe()),
+		DependsOn:   req.GetDependsOn(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot register service | %w", err)
+	}
+
+	setETag(ctx, registration)
+
+	return &servergrpc.RegisterServiceResponse{Service: mapService(registration)}, nil
+}
+
+func (s *Handlers) WatchServices(
+	req *servergrpc.WatchServicesRequest, stream servergrpc.ExampleService_WatchServicesServer,
+) error {
+	if err := s.Service.ExampleService.WatchServices(
+		stream.Context(), req.GetFromRevision(), req.GetServiceName(),
+		func(revision int64) error {
+			// an early header lets clients know that no change made from now on will be missed
+			return stream.SendHeader(metadata.Pairs(RevisionHeader, strconv.FormatInt(revision, 10)))
+		},
+		func(event *exampleSvc.Event) error {
+			return stream.Send(mapEvent(event))
+		},
+	); err != nil {
+		return fmt.Errorf("cannot watch services | %w", err)
+	}
+
+	return nil
+}
+
+func mapEvent(event *exampleSvc.Event) *servergrpc.WatchServicesResponse {
+	var eventType servergrpc.ServiceEventType
+
+	switch event.Type {
+	case exampleSvc.EventTypeAdded:
+		eventType = servergrpc.ServiceEventType_SERVICE_EVENT_TYPE_ADDED
+	case exampleSvc.EventTypeUpdated:
+		eventType = servergrpc.ServiceEventType_SERVICE_EVENT_TYPE_UPDATED
+	case exampleSvc.EventTypeRemoved:
+		eventType = servergrpc.ServiceEventType_SERVICE_EVENT_TYPE_REMOVED
+	case exampleSvc.EventTypeInstanceAdded:
+		eventType = servergrpc.ServiceEventType_SERVICE_EVENT_TYPE_INSTANCE_ADDED
+	case exampleSvc.EventTypeInstanceRemoved:
+		eventType = servergrpc.ServiceEventType_SERVICE_EVENT_TYPE_INSTANCE_REMOVED
+	}
+
+	return &servergrpc.WatchServicesResponse{
+		Revision:  event.Revision,
+		Type:      eventType,
+		Service:   mapService(event.Registration),
+		ChangedAt: timestamppb.New(event.ChangedAt),
+		Instance:  mapInstance(event.Instance),
+	}
+}
+
+func (s *Handlers) GetService(
+	ctx context.Context, req *servergrpc.GetServiceRequest,
+) (*servergrpc.GetServiceResponse, error) {
+	registration, err := s.Service.ExampleService.GetService(ctx, req.GetServiceName())
+	if err != nil {
+		return nil, fmt.Errorf("cannot get service | %w", err)
+	}
+
+	setETag(ctx, registration)
+
+	return &servergrpc.GetServiceResponse{Service: mapService(registration)}, nil
+}
+
+func (s *Handlers) UpdateService(
+	ctx context.Context, req *servergrpc.UpdateServiceRequest,
+) (*servergrpc.UpdateServiceResponse, error) {
+	registration, err := s.Service.ExampleService.UpdateService(ctx, req.GetServiceName(), &exampleSvc.Registration{
+		ServiceName: req.GetNewServiceName(),
+		Description: req.GetDescription(),
+		OwnerTeam:   req.GetOwnerTeam(),
+		Version:     req.GetVersion(),
+		Endpoints:   mapEndpointsToSvc(req.GetEndpoints()),
+		Labels:      req.GetLabels(),
+		Probe:       mapProbeToSvc(req.GetProbe()),
+		DependsOn:   req.GetDependsOn(),
+	}, req.GetResourceVersion())
+	if err != nil {
+		return nil, fmt.Errorf("cannot update service | %w", err)
+	}
+
+	setETag(ctx, registration)
+
+	return &servergrpc.UpdateServiceResponse{Service: mapService(registration)}, nil
+}
+
+func (s *Handlers) PatchService(
+	ctx context.Context, req *servergrpc.PatchServiceRequest,
+) (*servergrpc.PatchServiceResponse, error) {
+	patch := req.GetService()
+
+	registration, err := s.Service.ExampleService.PatchService(ctx, req.GetServiceName(), &exampleSvc.Registration{
+		ServiceName: patch.GetServiceName(),
+		Description: patch.GetDescription(),
+		OwnerTeam:   patch.GetOwnerTeam(),
+		Version:     patch.GetVersion(),
+		Endpoints:   mapEndpointsToSvc(patch.GetEndpoints()),
+		Labels:      patch.GetLabels(),
+		Probe:       mapProbeToSvc(patch.GetProbe()),
+		DependsOn:   patch.GetDependsOn(),
+	}, req.GetUpdateMask().GetPaths(), req.GetResourceVersion())
+	if err != nil {
+		return nil, fmt.Errorf("cannot patch service | %w", err)
+	}
+
+	setETag(ctx, registration)
+
+	return &servergrpc.PatchServiceResponse{Service: mapService(registration)}, nil
+}
+
+func (s *Handlers) Heartbeat(
+	ctx context.Context, req *servergrpc.HeartbeatRequest,
+) (*servergrpc.HeartbeatResponse, error) {
+	registration, err := s.Service.ExampleService.Heartbeat(ctx, req.GetServiceName())
+	if err != nil {
+		return nil, fmt.Errorf("cannot renew lease | %w", err)
 	}
+
+	setETag(ctx, registration)
+
+	return &servergrpc.HeartbeatResponse{Service: mapService(registration)}, nil
+}
+
+// setETag sends the resource version as a response header, the gateway turns it into ETag.
+// It is best effort: gRPC clients read Service.ResourceVersion instead.
+func setETag(ctx context.Context, registration *exampleSvc.Registration) {
+	_ = grpc.SetHeader(ctx, metadata.Pairs(etag.Header, etag.Format(registration.ResourceVersion)))
+}
+
+func (s *Handlers) GetServiceHistory(
+	ctx context.Context, req *servergrpc.GetServiceHistoryRequest,
+) (*servergrpc.GetServiceHistoryResponse, error) {
+	params := &exampleSvc.ServiceHistoryParams{
+		ServiceName: req.GetServiceName(),
+		From:        nil,
+		To:          nil,
+		PageSize:    int(req.GetPageSize()),
+		PageToken:   req.GetPageToken(),
+	}
+
+	if req.GetFrom() != nil {
+		from := req.GetFrom().AsTime()
+		params.From = &from
+	}
+
+	if req.GetTo() != nil {
+		to := req.GetTo().AsTime()
+		params.To = &to
+	}
+
+	page, err := s.Service.ExampleService.GetServiceHistory(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get service history | %w", err)
+	}
+
+	events := make([]*servergrpc.ServiceHistoryEvent, 0, len(page.Events))
+	for _, event := range page.Events {
+		events = append(events, mapHistoryEvent(event))
+	}
+
+	return &servergrpc.GetServiceHistoryResponse{
+		Events:        events,
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
+
+func mapHistoryEvent(event *exampleSvc.HistoryEvent) *servergrpc.ServiceHistoryEvent {
+	var eventType servergrpc.ServiceHistoryEventType
+
+	switch event.Type {
+	case exampleSvc.HistoryEventTypeRegistered:
+		eventType = servergrpc.ServiceHistoryEventType_SERVICE_HISTORY_EVENT_TYPE_REGISTERED
+	case exampleSvc.HistoryEventTypeUpdated:
+		eventType = servergrpc.ServiceHistoryEventType_SERVICE_HISTORY_EVENT_TYPE_UPDATED
+	case exampleSvc.HistoryEventTypeRenamed:
+		eventType = servergrpc.ServiceHistoryEventType_SERVICE_HISTORY_EVENT_TYPE_RENAMED
+	case exampleSvc.HistoryEventTypeExpired:
+		eventType = servergrpc.ServiceHistoryEventType_SERVICE_HISTORY_EVENT_TYPE_EXPIRED
+	case exampleSvc.HistoryEventTypeRevived:
+		eventType = servergrpc.ServiceHistoryEventType_SERVICE_HISTORY_EVENT_TYPE_REVIVED
+	case exampleSvc.HistoryEventTypeUnregistered:
+		eventType = servergrpc.ServiceHistoryEventType_SERVICE_HISTORY_EVENT_TYPE_UNREGISTERED
+	case exampleSvc.HistoryEventTypeDeleted:
+		eventType = servergrpc.ServiceHistoryEventType_SERVICE_HISTORY_EVENT_TYPE_DELETED
+	}
+
+	historyEvent := &servergrpc.ServiceHistoryEvent{
+		Id:          event.ID,
+		ServiceName: event.ServiceName,
+		Type:        eventType,
+		Actor:       event.Actor,
+		OccurredAt:  timestamppb.New(event.OccurredAt),
+	}
+
+	if event.OldValue != nil {
+		historyEvent.OldValue = mapService(event.OldValue)
+	}
+
+	if event.NewValue != nil {
+		historyEvent.NewValue = mapService(event.NewValue)
+	}
+
+	return historyEvent
+}
+
+func (s *Handlers) GetServiceDependencies(
+	ctx context.Context, req *servergrpc.GetServiceDependenciesRequest,
+) (*servergrpc.GetServiceDependenciesResponse, error) {
+	graph, err := s.Service.ExampleService.GetDependencies(
+		ctx, req.GetServiceName(), mapDependencyDirection(req.GetDirection()), int(req.GetMaxDepth()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get service dependencies | %w", err)
+	}
+
+	resp := &servergrpc.GetServiceDependenciesResponse{
+		Dependencies: make([]*servergrpc.DependencyNode, 0, len(graph.Nodes)),
+		Edges:        make([]*servergrpc.DependencyEdge, 0, len(graph.Edges)),
+	}
+
+	for _, node := range graph.Nodes {
+		if node.ServiceName == graph.Root {
+			continue
+		}
+
+		resp.Dependencies = append(resp.Dependencies, &servergrpc.DependencyNode{
+			ServiceName: node.ServiceName,
+			Depth:       int32(node.Depth),
+		})
+	}
+
+	for _, edge := range graph.Edges {
+		resp.Edges = append(resp.Edges, &servergrpc.DependencyEdge{
+			ServiceName: edge.ServiceName,
+			DependsOn:   edge.DependsOn,
+		})
+	}
+
+	return resp, nil
+}
+
+func (s *Handlers) GetDependencyGraph(
+	ctx context.Context, req *servergrpc.GetDependencyGraphRequest,
+) (*servergrpc.GetDependencyGraphResponse, error) {
+	graph, err := s.Service.ExampleService.GetDependencies(
+		ctx, req.GetServiceName(), mapDependencyDirection(req.GetDirection()), int(req.GetMaxDepth()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get dependency graph | %w", err)
+	}
+
+	switch req.GetFormat() {
+	case servergrpc.GraphFormat_GRAPH_FORMAT_DOT:
+		return &servergrpc.GetDependencyGraphResponse{
+			Graph:       graph.DOT(),
+			ContentType: "text/vnd.graphviz",
+		}, nil
+	case servergrpc.GraphFormat_GRAPH_FORMAT_UNSPECIFIED, servergrpc.GraphFormat_GRAPH_FORMAT_JSON:
+	}
+
+	raw, err := graph.JSON()
+	if err != nil {
+		return nil, fmt.Errorf("cannot render dependency graph | %w", err)
+	}
+
+	return &servergrpc.GetDependencyGraphResponse{
+		Graph:       string(raw),
+		ContentType: "application/json",
+	}, nil
+}
+
+func mapDependencyDirection(direction servergrpc.DependencyDirection) exampleSvc.DependencyDirection {
+	switch direction {
+	case servergrpc.DependencyDirection_DEPENDENCY_DIRECTION_DOWNSTREAM:
+		return exampleSvc.DependencyDirectionDownstream
+	case servergrpc.DependencyDirection_DEPENDENCY_DIRECTION_UNSPECIFIED,
+		servergrpc.DependencyDirection_DEPENDENCY_DIRECTION_UPSTREAM:
+		return exampleSvc.DependencyDirectionUpstream
+	default:
+		return exampleSvc.DependencyDirectionUpstream
+	}
+}
+
+func (s *Handlers) UnregisterService(
+	ctx context.Context, req *servergrpc.UnregisterServiceRequest,
+) (*emptypb.Empty, error) {
+	if err := s.Service.ExampleService.UnregisterService(
+		ctx, req.GetServiceName(), req.GetResourceVersion(),
+	); err != nil {
+		return nil, fmt.Errorf("cannot unregister service | %w", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Handlers) ListServices(
+	ctx context.Context, req *servergrpc.ListServicesRequest,
+) (*servergrpc.ListServicesResponse, error) {
+	page, err := s.Service.ExampleService.ListServices(ctx, &exampleSvc.ListServicesParams{
+		PageSize:      int(req.GetPageSize()),
+		PageToken:     req.GetPageToken(),
+		NamePrefix:    req.GetNamePrefix(),
+		LabelSelector: req.GetLabelSelector(),
+		SortField:     mapSortField(req.GetSortField()),
+		Descending:    req.GetSortOrder() == servergrpc.SortOrder_SORT_ORDER_DESC,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot list services | %w", err)
+	}
+
+	services := make([]*servergrpc.Service, 0, len(page.Registrations))
+	for _, registration := range page.Registrations {
+		services = append(services, mapService(registration))
+	}
+
+	return &servergrpc.ListServicesResponse{
+		Services:      services,
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
+
+func mapSortField(field servergrpc.SortField) exampleSvc.SortField {
+	switch field {
+	case servergrpc.SortField_SORT_FIELD_UNSPECIFIED, servergrpc.SortField_SORT_FIELD_SERVICE_NAME:
+		return exampleSvc.SortFieldServiceName
+	default:
+		return exampleSvc.SortFieldServiceName
+	}
+}
+
+func mapService(registration *exampleSvc.Registration) *servergrpc.Service {
+	endpoints := make([]*servergrpc.Endpoint, 0, len(registration.Endpoints))
+	for _, endpoint := range registration.Endpoints {
+		endpoints = append(endpoints, &servergrpc.Endpoint{
+			Protocol: mapEndpointProtocol(endpoint.Protocol),
+			Address:  endpoint.Address,
+		})
+	}
+
+	return &servergrpc.Service{
+		ServiceName: registration.ServiceName,
+		Description: registration.Description,
+		OwnerTeam:   registration.OwnerTeam,
+		Version:     registration.Version,
+		Endpoints:   endpoints,
+		Labels:      registration.Labels,
+		CreatedAt:   timestamppb.New(registration.CreatedAt),
+		UpdatedAt:   timestamppb.New(registration.UpdatedAt),
+
+		Ttl:            durationpb.New(registration.LeaseTTL),
+		LeaseExpiresAt: mapOptionalTime(registration.LeaseExpiresAt),
+		ExpiredAt:      mapOptionalTime(registration.ExpiredAt),
+
+		Probe:     mapProbe(registration.Probe),
+		DependsOn: registration.DependsOn,
+
+		ResourceVersion: registration.ResourceVersion,
+	}
+}
+
+func mapProbe(probe *exampleSvc.Endpoint) *servergrpc.Probe {
+	if probe == nil {
+		return nil
+	}
+
+	return &servergrpc.Probe{
+		Protocol: mapEndpointProtocol(probe.Protocol),
+		Address:  probe.Address,
+	}
+}
+
+func mapProbeToSvc(probe *servergrpc.Probe) *exampleSvc.Endpoint {
+	if probe == nil {
+		return nil
+	}
+
+	endpoints := mapEndpointsToSvc([]*servergrpc.Endpoint{{
+		Protocol: probe.GetProtocol(),
+		Address:  probe.GetAddress(),
+	}})
+
+	return &endpoints[0]
+}
+
+func mapOptionalTime(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+
+	return timestamppb.New(*t)
+}
+
+func mapEndpointProtocol(protocol exampleSvc.EndpointProtocol) servergrpc.EndpointProtocol {
+	switch protocol {
+	case exampleSvc.EndpointProtocolGRPC:
+		return servergrpc.EndpointProtocol_ENDPOINT_PROTOCOL_GRPC
+	case exampleSvc.EndpointProtocolHTTP:
+		return servergrpc.EndpointProtocol_ENDPOINT_PROTOCOL_HTTP
+	default:
+		return servergrpc.EndpointProtocol_ENDPOINT_PROTOCOL_UNSPECIFIED
+	}
+}
+
+func mapEndpointsToSvc(endpoints []*servergrpc.Endpoint) []exampleSvc.Endpoint {
+	result := make([]exampleSvc.Endpoint, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		var protocol exampleSvc.EndpointProtocol
+
+		switch endpoint.GetProtocol() {
+		case servergrpc.EndpointProtocol_ENDPOINT_PROTOCOL_GRPC:
+			protocol = exampleSvc.EndpointProtocolGRPC
+		case servergrpc.EndpointProtocol_ENDPOINT_PROTOCOL_HTTP:
+			protocol = exampleSvc.EndpointProtocolHTTP
+		case servergrpc.EndpointProtocol_ENDPOINT_PROTOCOL_UNSPECIFIED:
+		}
+
+		result = append(result, exampleSvc.Endpoint{
+			Protocol: protocol,
+			Address:  endpoint.GetAddress(),
+		})
+	}
+
+	return result
 }
@@ -0,0 +1,193 @@
+package example
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	servergrpc "github.com/ingvarmattis/example/gen/servergrpc/example"
+	exampleSvc "github.com/ingvarmattis/example/src/services/example"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Handlers) ExportServices(
+	req *servergrpc.ExportServicesRequest, stream servergrpc.ExampleService_ExportServicesServer,
+) error {
+	if err := s.Service.ExampleService.ExportServices(
+		stream.Context(), req.GetIncludeHistory(),
+		func(record *exampleSvc.ExportRecord) error {
+			if record.Event != nil {
+				return stream.Send(&servergrpc.ExportServicesResponse{
+					Record: &servergrpc.ExportServicesResponse_Event{Event: mapHistoryEvent(record.Event)},
+				})
+			}
+
+			return stream.Send(&servergrpc.ExportServicesResponse{
+				Record: &servergrpc.ExportServicesResponse_Service{Service: mapService(record.Registration)},
+			})
+		},
+	); err != nil {
+		return fmt.Errorf("cannot export services | %w", err)
+	}
+
+	return nil
+}
+
+func (s *Handlers) ImportServices(stream servergrpc.ExampleService_ImportServicesServer) error {
+	params := &exampleSvc.ImportParams{
+		Registrations: nil,
+		Events:        nil,
+		Mode:          exampleSvc.ImportModeFail,
+		DryRun:        false,
+	}
+
+	for first := true; ; first = false {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return fmt.Errorf("cannot receive import record | %w", err)
+		}
+
+		if first {
+			params.Mode = mapImportMode(req.GetOptions().GetConflictMode())
+			params.DryRun = req.GetOptions().GetDryRun()
+		}
+
+		switch record := req.GetRecord().(type) {
+		case *servergrpc.ImportServicesRequest_Service:
+			params.Registrations = append(params.Registrations, mapServiceToSvc(record.Service))
+		case *servergrpc.ImportServicesRequest_Event:
+			params.Events = append(params.Events, mapHistoryEventToSvc(record.Event))
+		}
+	}
+
+	result, err := s.Service.ExampleService.ImportServices(stream.Context(), params)
+	if err != nil {
+		return fmt.Errorf("cannot import services | %w", err)
+	}
+
+	resp := &servergrpc.ImportServicesResponse{
+		Diff:           make([]*servergrpc.ImportDiff, 0, len(result.Diff)),
+		EventsImported: int32(result.EventsImported),
+		DryRun:         params.DryRun,
+	}
+
+	for _, diff := range result.Diff {
+		resp.Diff = append(resp.Diff, &servergrpc.ImportDiff{
+			ServiceName:   diff.ServiceName,
+			Action:        mapImportAction(diff.Action),
+			ChangedFields: diff.ChangedFields,
+		})
+	}
+
+	return stream.SendAndClose(resp)
+}
+
+func mapImportMode(mode servergrpc.ImportConflictMode) exampleSvc.ImportMode {
+	switch mode {
+	case servergrpc.ImportConflictMode_IMPORT_CONFLICT_MODE_SKIP:
+		return exampleSvc.ImportModeSkip
+	case servergrpc.ImportConflictMode_IMPORT_CONFLICT_MODE_UPSERT:
+		return exampleSvc.ImportModeUpsert
+	case servergrpc.ImportConflictMode_IMPORT_CONFLICT_MODE_UNSPECIFIED,
+		servergrpc.ImportConflictMode_IMPORT_CONFLICT_MODE_FAIL:
+		return exampleSvc.ImportModeFail
+	default:
+		return exampleSvc.ImportModeFail
+	}
+}
+
+func mapImportAction(action exampleSvc.ImportAction) servergrpc.ImportAction {
+	switch action {
+	case exampleSvc.ImportActionCreate:
+		return servergrpc.ImportAction_IMPORT_ACTION_CREATE
+	case exampleSvc.ImportActionUpdate:
+		return servergrpc.ImportAction_IMPORT_ACTION_UPDATE
+	case exampleSvc.ImportActionUnchanged:
+		return servergrpc.ImportAction_IMPORT_ACTION_UNCHANGED
+	case exampleSvc.ImportActionSkip:
+		return servergrpc.ImportAction_IMPORT_ACTION_SKIP
+	default:
+		return servergrpc.ImportAction_IMPORT_ACTION_UNSPECIFIED
+	}
+}
+
+// mapServiceToSvc is the reverse of mapService. Read-only fields are kept for the snapshots of history events,
+// writes of the registry ignore them.
+func mapServiceToSvc(service *servergrpc.Service) *exampleSvc.Registration {
+	return &exampleSvc.Registration{
+		ServiceName: service.GetServiceName(),
+		Description: service.GetDescription(),
+		OwnerTeam:   service.GetOwnerTeam(),
+		Version:     service.GetVersion(),
+		Endpoints:   mapEndpointsToSvc(service.GetEndpoints()),
+		Labels:      service.GetLabels(),
+		CreatedAt:   service.GetCreatedAt().AsTime(),
+		UpdatedAt:   service.GetUpdatedAt().AsTime(),
+
+		LeaseTTL:       service.GetTtl().AsDuration(),
+		LeaseExpiresAt: mapOptionalTimeToSvc(service.GetLeaseExpiresAt()),
+		ExpiredAt:      mapOptionalTimeToSvc(service.GetExpiredAt()),
+
+		Probe:     mapProbeToSvc(service.GetProbe()),
+		DependsOn: service.GetDependsOn(),
+
+		ResourceVersion: service.GetResourceVersion(),
+	}
+}
+
+func mapOptionalTimeToSvc(t *timestamppb.Timestamp) *time.Time {
+	if t == nil {
+		return nil
+	}
+
+	result := t.AsTime()
+
+	return &result
+}
+
+func mapHistoryEventToSvc(event *servergrpc.ServiceHistoryEvent) *exampleSvc.HistoryEvent {
+	var eventType exampleSvc.HistoryEventType
+
+	switch event.GetType() {
+	case servergrpc.ServiceHistoryEventType_SERVICE_HISTORY_EVENT_TYPE_REGISTERED:
+		eventType = exampleSvc.HistoryEventTypeRegistered
+	case servergrpc.ServiceHistoryEventType_SERVICE_HISTORY_EVENT_TYPE_UPDATED:
+		eventType = exampleSvc.HistoryEventTypeUpdated
+	case servergrpc.ServiceHistoryEventType_SERVICE_HISTORY_EVENT_TYPE_RENAMED:
+		eventType = exampleSvc.HistoryEventTypeRenamed
+	case servergrpc.ServiceHistoryEventType_SERVICE_HISTORY_EVENT_TYPE_EXPIRED:
+		eventType = exampleSvc.HistoryEventTypeExpired
+	case servergrpc.ServiceHistoryEventType_SERVICE_HISTORY_EVENT_TYPE_REVIVED:
+		eventType = exampleSvc.HistoryEventTypeRevived
+	case servergrpc.ServiceHistoryEventType_SERVICE_HISTORY_EVENT_TYPE_UNREGISTERED:
+		eventType = exampleSvc.HistoryEventTypeUnregistered
+	case servergrpc.ServiceHistoryEventType_SERVICE_HISTORY_EVENT_TYPE_DELETED:
+		eventType = exampleSvc.HistoryEventTypeDeleted
+	case servergrpc.ServiceHistoryEventType_SERVICE_HISTORY_EVENT_TYPE_UNSPECIFIED:
+	}
+
+	historyEvent := &exampleSvc.HistoryEvent{
+		ID:          event.GetId(),
+		ServiceName: event.GetServiceName(),
+		Type:        eventType,
+		Actor:       event.GetActor(),
+		OldValue:    nil,
+		NewValue:    nil,
+		OccurredAt:  event.GetOccurredAt().AsTime(),
+	}
+
+	if event.GetOldValue() != nil {
+		historyEvent.OldValue = mapServiceToSvc(event.GetOldValue())
+	}
+
+	if event.GetNewValue() != nil {
+		historyEvent.NewValue = mapServiceToSvc(event.GetNewValue())
+	}
+
+	return historyEvent
+}
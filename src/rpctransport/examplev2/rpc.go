@@ -0,0 +1,275 @@
+package examplev2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	servergrpcv2 "github.com/ingvarmattis/example/gen/servergrpc/examplev2"
+	"github.com/ingvarmattis/example/src/etag"
+	"github.com/ingvarmattis/example/src/rpctransport"
+	"github.com/ingvarmattis/example/src/services"
+	exampleSvc "github.com/ingvarmattis/example/src/services/example"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Handlers serve the v2 API from the same service layer as v1.
+type Handlers struct {
+	Service services.SvcLayer
+}
+
+func (s *Handlers) CreateService(
+	ctx context.Context, req *servergrpcv2.CreateServiceRequest,
+) (*servergrpcv2.Service, error) {
+	registration := mapServiceToSvc(req.GetService())
+	registration.ServiceName = req.GetServiceId()
+	registration.LeaseTTL = req.GetService().GetLease().GetTtl().AsDuration()
+
+	created, err := s.Service.ExampleService.RegisterService(ctx, registration)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create service | %w", err)
+	}
+
+	setETag(ctx, created)
+
+	return mapService(created), nil
+}
+
+func (s *Handlers) ListServices(
+	ctx context.Context, req *servergrpcv2.ListServicesRequest,
+) (*servergrpcv2.ListServicesResponse, error) {
+	page, err := s.Service.ExampleService.ListServices(ctx, &exampleSvc.ListServicesParams{
+		PageSize:      int(req.GetPageSize()),
+		PageToken:     req.GetPageToken(),
+		NamePrefix:    "",
+		LabelSelector: req.GetFilter(),
+		SortField:     exampleSvc.SortFieldServiceName,
+		Descending:    strings.HasSuffix(req.GetOrderBy(), " desc"),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot list services | %w", err)
+	}
+
+	services := make([]*servergrpcv2.Service, 0, len(page.Registrations))
+	for _, registration := range page.Registrations {
+		services = append(services, mapService(registration))
+	}
+
+	return &servergrpcv2.ListServicesResponse{
+		Services:      services,
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
+
+func (s *Handlers) GetService(
+	ctx context.Context, req *servergrpcv2.GetServiceRequest,
+) (*servergrpcv2.Service, error) {
+	registration, err := s.Service.ExampleService.GetService(ctx, serviceName(req.GetName()))
+	if err != nil {
+		return nil, fmt.Errorf("cannot get service | %w", err)
+	}
+
+	setETag(ctx, registration)
+
+	return mapService(registration), nil
+}
+
+// UpdateService patches the fields named by the mask, their paths match the ones of the service layer.
+func (s *Handlers) UpdateService(
+	ctx context.Context, req *servergrpcv2.UpdateServiceRequest,
+) (*servergrpcv2.Service, error) {
+	expectedVersion, err := resourceVersion(req.GetService().GetEtag())
+	if err != nil {
+		return nil, err
+	}
+
+	registration, err := s.Service.ExampleService.PatchService(
+		ctx, serviceName(req.GetService().GetName()), mapServiceToSvc(req.GetService()),
+		req.GetUpdateMask().GetPaths(), expectedVersion,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("cannot update service | %w", err)
+	}
+
+	setETag(ctx, registration)
+
+	return mapService(registration), nil
+}
+
+func (s *Handlers) RenewLease(
+	ctx context.Context, req *servergrpcv2.RenewLeaseRequest,
+) (*servergrpcv2.Service, error) {
+	registration, err := s.Service.ExampleService.Heartbeat(ctx, serviceName(req.GetName()))
+	if err != nil {
+		return nil, fmt.Errorf("cannot renew lease | %w", err)
+	}
+
+	setETag(ctx, registration)
+
+	return mapService(registration), nil
+}
+
+func (s *Handlers) DeleteService(
+	ctx context.Context, req *servergrpcv2.DeleteServiceRequest,
+) (*emptypb.Empty, error) {
+	expectedVersion, err := resourceVersion(req.GetEtag())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.Service.ExampleService.UnregisterService(ctx, serviceName(req.GetName()), expectedVersion); err != nil {
+		return nil, fmt.Errorf("cannot delete service | %w", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// setETag sends the entity tag as a response header as well, the gateway turns it into ETag.
+func setETag(ctx context.Context, registration *exampleSvc.Registration) {
+	_ = grpc.SetHeader(ctx, metadata.Pairs(etag.Header, etag.Format(registration.ResourceVersion)))
+}
+
+// resourceVersion reads an Etag of a request, empty means the write is not conditional.
+func resourceVersion(entityTag string) (int64, error) {
+	if entityTag == "" {
+		return 0, nil
+	}
+
+	version, err := etag.ParseIfMatch(entityTag)
+	if err != nil {
+		return 0, fmt.Errorf("cannot parse etag | %w", err)
+	}
+
+	return version, nil
+}
+
+// serviceName returns the {service} of services/{service}.
+func serviceName(resourceName string) string {
+	return strings.TrimPrefix(resourceName, rpctransport.ServiceResourcePrefix)
+}
+
+func resourceName(serviceName string) string {
+	return rpctransport.ServiceResourcePrefix + serviceName
+}
+
+func mapService(registration *exampleSvc.Registration) *servergrpcv2.Service {
+	endpoints := make([]*servergrpcv2.Endpoint, 0, len(registration.Endpoints))
+	for _, endpoint := range registration.Endpoints {
+		endpoints = append(endpoints, &servergrpcv2.Endpoint{
+			Protocol: mapEndpointProtocol(endpoint.Protocol),
+			Address:  endpoint.Address,
+		})
+	}
+
+	dependsOn := make([]string, 0, len(registration.DependsOn))
+	for _, dependency := range registration.DependsOn {
+		dependsOn = append(dependsOn, resourceName(dependency))
+	}
+
+	service := &servergrpcv2.Service{
+		Name:        resourceName(registration.ServiceName),
+		Description: registration.Description,
+		OwnerTeam:   registration.OwnerTeam,
+		Version:     registration.Version,
+		Endpoints:   endpoints,
+		Labels:      registration.Labels,
+		Probe:       mapProbe(registration.Probe),
+		DependsOn:   dependsOn,
+		Lease:       nil,
+		State:       servergrpcv2.ServiceState_SERVICE_STATE_ACTIVE,
+		CreateTime:  timestamppb.New(registration.CreatedAt),
+		UpdateTime:  timestamppb.New(registration.UpdatedAt),
+		Etag:        etag.Format(registration.ResourceVersion),
+	}
+
+	if registration.LeaseTTL > 0 {
+		service.Lease = &servergrpcv2.Lease{
+			Ttl:        durationpb.New(registration.LeaseTTL),
+			ExpireTime: nil,
+		}
+
+		if registration.LeaseExpiresAt != nil {
+			service.Lease.ExpireTime = timestamppb.New(*registration.LeaseExpiresAt)
+		}
+	}
+
+	if registration.Expired() {
+		service.State = servergrpcv2.ServiceState_SERVICE_STATE_EXPIRED
+	}
+
+	return service
+}
+
+// mapServiceToSvc maps the writable fields, the lease is left to CreateService.
+func mapServiceToSvc(service *servergrpcv2.Service) *exampleSvc.Registration {
+	endpoints := make([]exampleSvc.Endpoint, 0, len(service.GetEndpoints()))
+	for _, endpoint := range service.GetEndpoints() {
+		endpoints = append(endpoints, exampleSvc.Endpoint{
+			Protocol: mapEndpointProtocolToSvc(endpoint.GetProtocol()),
+			Address:  endpoint.GetAddress(),
+		})
+	}
+
+	var probe *exampleSvc.Endpoint
+	if service.GetProbe() != nil {
+		probe = &exampleSvc.Endpoint{
+			Protocol: mapEndpointProtocolToSvc(service.GetProbe().GetProtocol()),
+			Address:  service.GetProbe().GetAddress(),
+		}
+	}
+
+	dependsOn := make([]string, 0, len(service.GetDependsOn()))
+	for _, dependency := range service.GetDependsOn() {
+		dependsOn = append(dependsOn, serviceName(dependency))
+	}
+
+	return &exampleSvc.Registration{
+		ServiceName: "",
+		Description: service.GetDescription(),
+		OwnerTeam:   service.GetOwnerTeam(),
+		Version:     service.GetVersion(),
+		Endpoints:   endpoints,
+		Labels:      service.GetLabels(),
+		Probe:       probe,
+		DependsOn:   dependsOn,
+	}
+}
+
+func mapProbe(probe *exampleSvc.Endpoint) *servergrpcv2.Probe {
+	if probe == nil {
+		return nil
+	}
+
+	return &servergrpcv2.Probe{
+		Protocol: mapEndpointProtocol(probe.Protocol),
+		Address:  probe.Address,
+	}
+}
+
+func mapEndpointProtocol(protocol exampleSvc.EndpointProtocol) servergrpcv2.EndpointProtocol {
+	switch protocol {
+	case exampleSvc.EndpointProtocolGRPC:
+		return servergrpcv2.EndpointProtocol_ENDPOINT_PROTOCOL_GRPC
+	case exampleSvc.EndpointProtocolHTTP:
+		return servergrpcv2.EndpointProtocol_ENDPOINT_PROTOCOL_HTTP
+	default:
+		return servergrpcv2.EndpointProtocol_ENDPOINT_PROTOCOL_UNSPECIFIED
+	}
+}
+
+func mapEndpointProtocolToSvc(protocol servergrpcv2.EndpointProtocol) exampleSvc.EndpointProtocol {
+	switch protocol {
+	case servergrpcv2.EndpointProtocol_ENDPOINT_PROTOCOL_GRPC:
+		return exampleSvc.EndpointProtocolGRPC
+	case servergrpcv2.EndpointProtocol_ENDPOINT_PROTOCOL_HTTP:
+		return exampleSvc.EndpointProtocolHTTP
+	case servergrpcv2.EndpointProtocol_ENDPOINT_PROTOCOL_UNSPECIFIED:
+		return ""
+	default:
+		return ""
+	}
+}
@@ -2,17 +2,70 @@ package rpctransport
 
 import (
 	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
 
 	"github.com/go-playground/validator/v10"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
+const (
+	serviceNameMaxLength = 63
+	labelNameMaxLength   = 63
+	instanceIDMaxLength  = 253
+
+	// FieldPathTag overrides the name of a validated field with its path in the request message,
+	// for fields whose names differ from the proto ones, e.g. `proto:"Service.Description"`.
+	FieldPathTag = "proto"
+
+	// ServiceResourcePrefix starts the resource names of services in v2, services/{service}.
+	ServiceResourcePrefix = "services/"
+)
+
+// labelKeyRegexp follows Kubernetes label keys: an optional DNS prefix followed by a slash and a name.
+var labelKeyRegexp = regexp.MustCompile(
+	`^([a-z0-9]([-a-z0-9.]*[a-z0-9])?/)?[A-Za-z0-9]([-A-Za-z0-9_.]*[A-Za-z0-9])?$`,
+)
+
+// serviceNameRegexp follows DNS labels, so a service name fits a host name and a path segment.
+var serviceNameRegexp = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+
+// instanceIDRegexp accepts host and pod names, ids of instances start and end with an alphanumeric character.
+var instanceIDRegexp = regexp.MustCompile(`^[A-Za-z0-9]([-A-Za-z0-9_.]*[A-Za-z0-9])?$`)
+
 func NewValidator() (*validator.Validate, error) {
 	validate := validator.New()
 
+	// errors name fields by their path in the request message, see FieldPathTag
+	validate.RegisterTagNameFunc(func(field reflect.StructField) string {
+		if path := field.Tag.Get(FieldPathTag); path != "" {
+			return path
+		}
+
+		return field.Name
+	})
+
 	if err := validate.RegisterValidation("serviceName", validateServiceName); err != nil {
 		return nil, fmt.Errorf("error while register validation `serviceName` | %w", err)
 	}
 
+	if err := validate.RegisterValidation("instanceID", validateInstanceID); err != nil {
+		return nil, fmt.Errorf("error while register validation `instanceID` | %w", err)
+	}
+
+	if err := validate.RegisterValidation("labelKey", validateLabelKey); err != nil {
+		return nil, fmt.Errorf("error while register validation `labelKey` | %w", err)
+	}
+
+	if err := validate.RegisterValidation("protoEnum", validateProtoEnum); err != nil {
+		return nil, fmt.Errorf("error while register validation `protoEnum` | %w", err)
+	}
+
+	if err := validate.RegisterValidation("serviceResourceName", validateServiceResourceName); err != nil {
+		return nil, fmt.Errorf("error while register validation `serviceResourceName` | %w", err)
+	}
+
 	return validate, nil
 }
 
@@ -26,7 +79,40 @@ func MustValidate() *validator.Validate {
 }
 
 func validateServiceName(fl validator.FieldLevel) bool {
-	serviceName := fl.Field().String()
+	return isServiceName(fl.Field().String())
+}
+
+func isServiceName(serviceName string) bool {
+	return len(serviceName) <= serviceNameMaxLength && serviceNameRegexp.MatchString(serviceName)
+}
+
+func validateInstanceID(fl validator.FieldLevel) bool {
+	instanceID := fl.Field().String()
+
+	return len(instanceID) <= instanceIDMaxLength && instanceIDRegexp.MatchString(instanceID)
+}
+
+// validateServiceResourceName accepts services/{service} where {service} is a valid service name.
+func validateServiceResourceName(fl validator.FieldLevel) bool {
+	serviceName, ok := strings.CutPrefix(fl.Field().String(), ServiceResourcePrefix)
+
+	return ok && isServiceName(serviceName)
+}
+
+// validateProtoEnum rejects enum numbers that are not declared in the .proto file.
+func validateProtoEnum(fl validator.FieldLevel) bool {
+	enum, ok := fl.Field().Interface().(protoreflect.Enum)
+	if !ok {
+		return false
+	}
+
+	return enum.Descriptor().Values().ByNumber(enum.Number()) != nil
+}
+
+func validateLabelKey(fl validator.FieldLevel) bool {
+	key := fl.Field().String()
+
+	name := key[strings.LastIndex(key, "/")+1:]
 
-	return len(serviceName) != 0
+	return len(name) <= labelNameMaxLength && labelKeyRegexp.MatchString(key)
 }
@@ -0,0 +1,145 @@
+package example
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	exampleRepo "github.com/ingvarmattis/example/src/repositories/example"
+)
+
+type DependencyDirection int
+
+const (
+	// DependencyDirectionUpstream lists what a service depends on, what it needs to work.
+	DependencyDirectionUpstream DependencyDirection = iota
+	// DependencyDirectionDownstream lists what depends on a service, what breaks if it goes down.
+	DependencyDirectionDownstream
+)
+
+// DependencyGraph is a part of the dependency graph reachable from Root. An edge means ServiceName depends on DependsOn.
+type DependencyGraph struct {
+	// Root is empty for the graph of the whole registry.
+	Root  string            `json:"root,omitempty"`
+	Nodes []*DependencyNode `json:"nodes"`
+	Edges []*DependencyEdge `json:"edges"`
+}
+
+type DependencyNode struct {
+	ServiceName string `json:"serviceName"`
+	// Depth is the number of edges between the node and Root, zero for Root and for the whole registry.
+	Depth int `json:"depth"`
+}
+
+type DependencyEdge struct {
+	ServiceName string `json:"serviceName"`
+	DependsOn   string `json:"dependsOn"`
+}
+
+// GetDependencies walks the dependency graph from serviceName in direction, at most maxDepth edges away.
+// Zero maxDepth has no limit. An empty serviceName returns the graph of the whole registry.
+func (s *Service) GetDependencies(
+	ctx context.Context, serviceName string, direction DependencyDirection, maxDepth int,
+) (*DependencyGraph, error) {
+	if serviceName != "" {
+		exists, err := s.exampleStorage.Exists(ctx, serviceName)
+		if err != nil {
+			return nil, fmt.Errorf("cannot get dependencies | %w", mapStorageError(err))
+		}
+
+		if !exists {
+			return nil, fmt.Errorf("cannot get dependencies | %w", ErrNotFound)
+		}
+	}
+
+	storageDirection := exampleRepo.DependencyDirectionUpstream
+	if direction == DependencyDirectionDownstream {
+		storageDirection = exampleRepo.DependencyDirectionDownstream
+	}
+
+	edges, err := s.exampleStorage.ListDependencyEdges(ctx, serviceName, storageDirection, maxDepth)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get dependencies | %w", mapStorageError(err))
+	}
+
+	graph := &DependencyGraph{
+		Root:  serviceName,
+		Nodes: make([]*DependencyNode, 0, len(edges)+1),
+		Edges: make([]*DependencyEdge, 0, len(edges)),
+	}
+
+	seen := make(map[string]struct{}, len(edges)+1)
+	addNode := func(name string, depth int) {
+		if _, ok := seen[name]; ok {
+			return
+		}
+
+		seen[name] = struct{}{}
+		graph.Nodes = append(graph.Nodes, &DependencyNode{ServiceName: name, Depth: depth})
+	}
+
+	if serviceName != "" {
+		addNode(serviceName, 0)
+	}
+
+	// edges come ordered by depth, so the first time a node shows up is its shortest distance
+	for _, edge := range edges {
+		graph.Edges = append(graph.Edges, &DependencyEdge{ServiceName: edge.ServiceName, DependsOn: edge.DependsOn})
+
+		switch {
+		case serviceName == "":
+			addNode(edge.ServiceName, 0)
+			addNode(edge.DependsOn, 0)
+		case direction == DependencyDirectionDownstream:
+			addNode(edge.ServiceName, edge.Depth)
+		default:
+			addNode(edge.DependsOn, edge.Depth)
+		}
+	}
+
+	return graph, nil
+}
+
+// JSON renders the graph as a JSON document with nodes and edges.
+func (g *DependencyGraph) JSON() ([]byte, error) {
+	raw, err := json.Marshal(g)
+	if err != nil {
+		return nil, fmt.Errorf("cannot marshal dependency graph | %w", err)
+	}
+
+	return raw, nil
+}
+
+// DOT renders the graph in the Graphviz DOT language, edges point from a service to its dependencies.
+func (g *DependencyGraph) DOT() string {
+	var b strings.Builder
+
+	b.WriteString("digraph dependencies {\n")
+
+	for _, node := range g.Nodes {
+		b.WriteString("  " + dotQuote(node.ServiceName))
+
+		if node.ServiceName == g.Root {
+			b.WriteString(" [style=bold]")
+		}
+
+		b.WriteString(";\n")
+	}
+
+	for _, edge := range g.Edges {
+		b.WriteString("  " + dotQuote(edge.ServiceName) + " -> " + dotQuote(edge.DependsOn) + ";\n")
+	}
+
+	b.WriteString("}\n")
+
+	return b.String()
+}
+
+// dotQuoter escapes what a quoted DOT ID cannot hold as is. Unlike strconv.Quote it leaves
+// other characters alone, DOT has no escapes such as \u00e9 and would print them literally.
+var dotQuoter = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+func dotQuote(id string) string {
+	return `"` + dotQuoter.Replace(id) + `"`
+}
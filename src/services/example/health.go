@@ -0,0 +1,186 @@
+package example
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	exampleRepo "github.com/ingvarmattis/example/src/repositories/example"
+)
+
+type HealthStatus int
+
+const (
+	// HealthStatusUnknown is reported until the first probe of a service has finished.
+	HealthStatusUnknown HealthStatus = iota
+	HealthStatusHealthy
+	HealthStatusDegraded
+	HealthStatusUnhealthy
+)
+
+// Health is the aggregated result of probing a service.
+type Health struct {
+	Status              HealthStatus
+	ConsecutiveFailures int
+	LastProbeLatency    time.Duration
+	// LastError is empty when the last probe succeeded.
+	LastError   string
+	LastProbeAt time.Time
+}
+
+type ProbeParams struct {
+	Interval time.Duration
+	// Timeout bounds a single probe.
+	Timeout     time.Duration
+	Concurrency int
+	// UnhealthyThreshold is the number of failed probes in a row after which a service is unhealthy,
+	// fewer failures make it degraded.
+	UnhealthyThreshold int
+	// DegradedLatency makes a service that answers successfully but slower than that degraded.
+	DegradedLatency time.Duration
+}
+
+type healthChecker interface {
+	Check(ctx context.Context, protocol, address string) error
+}
+
+// GetHealth returns the health of a service, HealthStatusUnknown when it has not been probed yet.
+func (s *Service) GetHealth(ctx context.Context, serviceName string) (*Health, error) {
+	health, err := s.exampleStorage.GetHealth(ctx, serviceName)
+	if err != nil {
+		if errors.Is(err, exampleRepo.ErrNotFound) {
+			return &Health{Status: HealthStatusUnknown}, nil
+		}
+
+		return nil, fmt.Errorf("cannot get service health | %w", mapStorageError(err))
+	}
+
+	return &Health{
+		Status:              s.healthStatus(health),
+		ConsecutiveFailures: health.ConsecutiveFailures,
+		LastProbeLatency:    health.Latency,
+		LastError:           health.LastError,
+		LastProbeAt:         health.ProbedAt,
+	}, nil
+}
+
+func (s *Service) healthStatus(health *exampleRepo.Health) HealthStatus {
+	switch {
+	case health.ConsecutiveFailures >= max(s.probeParams.UnhealthyThreshold, 1):
+		return HealthStatusUnhealthy
+	case health.ConsecutiveFailures > 0:
+		return HealthStatusDegraded
+	case s.probeParams.DegradedLatency > 0 && health.Latency > s.probeParams.DegradedLatency:
+		return HealthStatusDegraded
+	default:
+		return HealthStatusHealthy
+	}
+}
+
+// ProbeServices blocks until ctx is done, probing every active registration that has a probe each interval.
+// Only the replica holding the lock of the prober probes, the others try to take it over every interval.
+func (s *Service) ProbeServices(ctx context.Context) {
+	ticker := time.NewTicker(s.probeParams.Interval)
+	defer ticker.Stop()
+
+	var lock *exampleRepo.ProbeLock
+
+	defer func() {
+		if lock != nil {
+			lock.Release(context.WithoutCancel(ctx))
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if lock = s.holdProbeLock(ctx, lock); lock != nil {
+				s.probeServices(ctx)
+			}
+		}
+	}
+}
+
+// holdProbeLock returns the lock of the prober if this replica holds it or has just taken it, nil otherwise.
+func (s *Service) holdProbeLock(ctx context.Context, lock *exampleRepo.ProbeLock) *exampleRepo.ProbeLock {
+	if lock != nil {
+		err := lock.Check(ctx)
+		if err == nil {
+			return lock
+		}
+
+		s.logger.Warn("cannot hold probe lock", zap.Error(err))
+		lock.Release(context.WithoutCancel(ctx))
+	}
+
+	lock, err := s.exampleStorage.TryLockProbes(ctx)
+	if err != nil && ctx.Err() == nil {
+		s.logger.Error("cannot lock probes", zap.Error(err))
+	}
+
+	return lock
+}
+
+// probeServices runs one round of probes, at most Concurrency at a time, and waits for all of them,
+// so a round never overlaps with the next one.
+func (s *Service) probeServices(ctx context.Context) {
+	targets, err := s.exampleStorage.ListProbeTargets(ctx)
+	if err != nil {
+		s.logger.Error("cannot list probe targets", zap.Error(err))
+		return
+	}
+
+	slots := make(chan struct{}, max(s.probeParams.Concurrency, 1))
+	wg := &sync.WaitGroup{}
+
+	for _, target := range targets {
+		select {
+		case <-ctx.Done():
+			wg.Wait()
+			return
+		case slots <- struct{}{}:
+		}
+
+		wg.Go(func() {
+			defer func() { <-slots }()
+
+			s.probe(ctx, target)
+		})
+	}
+
+	wg.Wait()
+}
+
+func (s *Service) probe(ctx context.Context, target *exampleRepo.ProbeTarget) {
+	probeCTX, cancel := context.WithTimeout(ctx, s.probeParams.Timeout)
+	defer cancel()
+
+	startedAt := time.Now()
+	checkErr := s.healthChecker.Check(probeCTX, target.Probe.Protocol, target.Probe.Address)
+
+	result := &exampleRepo.ProbeResult{
+		ServiceName: target.ServiceName,
+		Latency:     time.Since(startedAt),
+		Error:       "",
+		ProbedAt:    startedAt,
+	}
+
+	if checkErr != nil {
+		// a probe cut short by shutdown says nothing about the service
+		if ctx.Err() != nil {
+			return
+		}
+
+		result.Error = checkErr.Error()
+	}
+
+	if err := s.exampleStorage.SaveProbeResult(ctx, result); err != nil && ctx.Err() == nil {
+		s.logger.Error("cannot save probe result", zap.String("service", target.ServiceName), zap.Error(err))
+	}
+}
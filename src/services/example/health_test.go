@@ -0,0 +1,187 @@
+package example
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ingvarmattis/example/src/log"
+	"github.com/ingvarmattis/example/src/probe"
+	exampleRepo "github.com/ingvarmattis/example/src/repositories/example"
+)
+
+// healthStorage keeps probe results in memory the way example.service_health does.
+type healthStorage struct {
+	exampleStorage
+
+	targets []*exampleRepo.ProbeTarget
+
+	mu     sync.Mutex
+	health map[string]*exampleRepo.Health
+}
+
+func (s *healthStorage) ListProbeTargets(context.Context) ([]*exampleRepo.ProbeTarget, error) {
+	return s.targets, nil
+}
+
+func (s *healthStorage) SaveProbeResult(_ context.Context, result *exampleRepo.ProbeResult) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	health, ok := s.health[result.ServiceName]
+	if !ok {
+		health = &exampleRepo.Health{ConsecutiveFailures: 0, Latency: 0, LastError: "", ProbedAt: time.Time{}}
+		s.health[result.ServiceName] = health
+	}
+
+	health.ConsecutiveFailures++
+	if result.Error == "" {
+		health.ConsecutiveFailures = 0
+	}
+
+	health.Latency = result.Latency
+	health.LastError = result.Error
+	health.ProbedAt = result.ProbedAt
+
+	return nil
+}
+
+func (s *healthStorage) GetHealth(_ context.Context, serviceName string) (*exampleRepo.Health, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	health, ok := s.health[serviceName]
+	if !ok {
+		return nil, exampleRepo.ErrNotFound
+	}
+
+	copied := *health
+
+	return &copied, nil
+}
+
+func TestProbeServicesTransitions(t *testing.T) {
+	const serviceName = "billing"
+
+	var (
+		failing atomic.Bool
+		delay   atomic.Int64
+	)
+
+	endpoint := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		time.Sleep(time.Duration(delay.Load()))
+
+		if failing.Load() {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer endpoint.Close()
+
+	storage := &healthStorage{
+		targets: []*exampleRepo.ProbeTarget{{
+			ServiceName: serviceName,
+			Probe:       exampleRepo.Endpoint{Protocol: probe.ProtocolHTTP, Address: endpoint.URL},
+		}},
+		health: make(map[string]*exampleRepo.Health),
+	}
+
+	service := &Service{
+		exampleStorage: storage,
+		logger:         log.NewZap(),
+		watchHub:       nil,
+		healthChecker:  probe.NewChecker(),
+		probeParams: &ProbeParams{
+			Interval:           time.Second,
+			Timeout:            time.Second,
+			Concurrency:        1,
+			UnhealthyThreshold: 2,
+			DegradedLatency:    100 * time.Millisecond,
+		},
+	}
+
+	ctx := context.Background()
+
+	steps := []struct {
+		name         string
+		failing      bool
+		delay        time.Duration
+		wantStatus   HealthStatus
+		wantFailures int
+	}{
+		{name: "not probed yet", failing: false, delay: 0, wantStatus: HealthStatusUnknown, wantFailures: 0},
+		{name: "answers", failing: false, delay: 0, wantStatus: HealthStatusHealthy, wantFailures: 0},
+		{name: "answers slowly", failing: false, delay: 200 * time.Millisecond, wantStatus: HealthStatusDegraded, wantFailures: 0},
+		{name: "fails once", failing: true, delay: 0, wantStatus: HealthStatusDegraded, wantFailures: 1},
+		{name: "fails twice", failing: true, delay: 0, wantStatus: HealthStatusUnhealthy, wantFailures: 2},
+		{name: "fails again", failing: true, delay: 0, wantStatus: HealthStatusUnhealthy, wantFailures: 3},
+		{name: "recovers", failing: false, delay: 0, wantStatus: HealthStatusHealthy, wantFailures: 0},
+	}
+
+	for i, step := range steps {
+		failing.Store(step.failing)
+		delay.Store(int64(step.delay))
+
+		if i > 0 {
+			service.probeServices(ctx)
+		}
+
+		health, err := service.GetHealth(ctx, serviceName)
+		if err != nil {
+			t.Fatalf("%s: GetHealth() error = %v", step.name, err)
+		}
+
+		if health.Status != step.wantStatus || health.ConsecutiveFailures != step.wantFailures {
+			t.Fatalf("%s: status %d with %d failures, want %d with %d",
+				step.name, health.Status, health.ConsecutiveFailures, step.wantStatus, step.wantFailures)
+		}
+	}
+}
+
+func TestProbeServicesTimeout(t *testing.T) {
+	const serviceName = "search"
+
+	endpoint := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer endpoint.Close()
+
+	storage := &healthStorage{
+		targets: []*exampleRepo.ProbeTarget{{
+			ServiceName: serviceName,
+			Probe:       exampleRepo.Endpoint{Protocol: probe.ProtocolHTTP, Address: endpoint.URL},
+		}},
+		health: make(map[string]*exampleRepo.Health),
+	}
+
+	service := &Service{
+		exampleStorage: storage,
+		logger:         log.NewZap(),
+		watchHub:       nil,
+		healthChecker:  probe.NewChecker(),
+		probeParams: &ProbeParams{
+			Interval:           time.Second,
+			Timeout:            50 * time.Millisecond,
+			Concurrency:        1,
+			UnhealthyThreshold: 1,
+			DegradedLatency:    0,
+		},
+	}
+
+	service.probeServices(context.Background())
+
+	health, err := service.GetHealth(context.Background(), serviceName)
+	if err != nil {
+		t.Fatalf("GetHealth() error = %v", err)
+	}
+
+	if health.Status != HealthStatusUnhealthy || health.LastError == "" {
+		t.Fatalf("status %d with error %q, want an unhealthy service with an error", health.Status, health.LastError)
+	}
+}
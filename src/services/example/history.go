@@ -0,0 +1,176 @@
+package example
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	exampleRepo "github.com/ingvarmattis/example/src/repositories/example"
+)
+
+type HistoryEventType int
+
+const (
+	HistoryEventTypeRegistered HistoryEventType = iota + 1
+	HistoryEventTypeUpdated
+	// HistoryEventTypeRenamed shows up in the history of both the old and the new name.
+	HistoryEventTypeRenamed
+	HistoryEventTypeExpired
+	HistoryEventTypeRevived
+	HistoryEventTypeUnregistered
+	// HistoryEventTypeDeleted means an expired registration was removed by the lease reaper.
+	HistoryEventTypeDeleted
+)
+
+// HistoryEvent is an entry of the audit trail of a service.
+type HistoryEvent struct {
+	ID          int64
+	ServiceName string
+	Type        HistoryEventType
+	Actor       string
+	// OldValue is nil for a registration, NewValue is nil once the service is gone.
+	OldValue   *Registration
+	NewValue   *Registration
+	OccurredAt time.Time
+}
+
+type ServiceHistoryParams struct {
+	ServiceName string
+	// From and To bound the history as [From, To), nil leaves the range open.
+	From      *time.Time
+	To        *time.Time
+	PageSize  int
+	PageToken string
+}
+
+type ServiceHistoryPage struct {
+	Events []*HistoryEvent
+	// NextPageToken is empty on the last page.
+	NextPageToken string
+}
+
+// historyPageToken is bound to the service and the time range it was issued for.
+type historyPageToken struct {
+	Version     int        `json:"v"`
+	ServiceName string     `json:"n"`
+	From        *time.Time `json:"f,omitempty"`
+	To          *time.Time `json:"t,omitempty"`
+	AfterID     int64      `json:"a"`
+}
+
+// GetServiceHistory returns the audit trail of a service, oldest events first.
+// The history outlives the registration, so it is available for services that are gone.
+func (s *Service) GetServiceHistory(ctx context.Context, params *ServiceHistoryParams) (*ServiceHistoryPage, error) {
+	filter := &exampleRepo.ServiceEventsFilter{
+		ServiceName: params.ServiceName,
+		From:        params.From,
+		To:          params.To,
+		AfterID:     0,
+		// one extra row tells whether there is a next page
+		Limit: pageSize(params.PageSize) + 1,
+	}
+
+	if params.PageToken != "" {
+		afterID, err := decodeHistoryPageToken(params)
+		if err != nil {
+			return nil, err
+		}
+
+		filter.AfterID = afterID
+	}
+
+	events, err := s.exampleStorage.ListServiceEvents(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get service history | %w", mapStorageError(err))
+	}
+
+	page := &ServiceHistoryPage{
+		Events: make([]*HistoryEvent, 0, len(events)),
+	}
+
+	if len(events) == filter.Limit {
+		events = events[:len(events)-1]
+
+		page.NextPageToken, err = encodePageToken(&historyPageToken{
+			Version:     pageTokenVersion,
+			ServiceName: params.ServiceName,
+			From:        params.From,
+			To:          params.To,
+			AfterID:     events[len(events)-1].ID,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, event := range events {
+		page.Events = append(page.Events, historyEventFromStorage(event))
+	}
+
+	return page, nil
+}
+
+func decodeHistoryPageToken(params *ServiceHistoryParams) (int64, error) {
+	var token historyPageToken
+	if err := unmarshalPageToken(params.PageToken, &token); err != nil {
+		return 0, err
+	}
+
+	if token.Version != pageTokenVersion ||
+		token.ServiceName != params.ServiceName ||
+		!sameOptionalTime(token.From, params.From) ||
+		!sameOptionalTime(token.To, params.To) {
+		return 0, ErrInvalidPageToken
+	}
+
+	return token.AfterID, nil
+}
+
+func sameOptionalTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return a.Equal(*b)
+}
+
+func historyEventFromStorage(event *exampleRepo.Event) *HistoryEvent {
+	var eventType HistoryEventType
+
+	switch event.Type {
+	case exampleRepo.EventTypeRegistered:
+		eventType = HistoryEventTypeRegistered
+	case exampleRepo.EventTypeUpdated:
+		eventType = HistoryEventTypeUpdated
+	case exampleRepo.EventTypeRenamed:
+		eventType = HistoryEventTypeRenamed
+	case exampleRepo.EventTypeExpired:
+		eventType = HistoryEventTypeExpired
+	case exampleRepo.EventTypeRevived:
+		eventType = HistoryEventTypeRevived
+	case exampleRepo.EventTypeUnregistered:
+		eventType = HistoryEventTypeUnregistered
+	case exampleRepo.EventTypeDeleted:
+		eventType = HistoryEventTypeDeleted
+	}
+
+	historyEvent := &HistoryEvent{
+		ID:          event.ID,
+		ServiceName: event.ServiceName,
+		Type:        eventType,
+		Actor:       event.Actor,
+		OldValue:    nil,
+		NewValue:    nil,
+		OccurredAt:  event.OccurredAt,
+	}
+
+	if event.OldValue != nil {
+		historyEvent.OldValue = registrationFromStorage(event.OldValue)
+	}
+
+	if event.NewValue != nil {
+		historyEvent.NewValue = registrationFromStorage(event.NewValue)
+	}
+
+	return historyEvent
+}
@@ -0,0 +1,122 @@
+package example
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	exampleRepo "github.com/ingvarmattis/example/src/repositories/example"
+)
+
+var ErrInstanceNotFound = errors.New("instance not found")
+
+// Instance is a running copy of a registered service. Every instance registers its own endpoints
+// and renews its own lease, so the registry drops a crashed instance without touching the others.
+type Instance struct {
+	ServiceName string
+	// InstanceID is unique within the service, such as the name of a pod.
+	InstanceID string
+	Endpoints  []Endpoint
+	// LeaseTTL is zero for instances that never expire.
+	LeaseTTL       time.Duration
+	LeaseExpiresAt *time.Time
+	RegisteredAt   time.Time
+}
+
+// RegisterInstance adds an instance to a registered service, or replaces the endpoints of one registered
+// before and starts its lease over. It fails with ErrNotFound when the service is not registered.
+func (s *Service) RegisterInstance(ctx context.Context, instance *Instance) (*Instance, error) {
+	registered, err := s.exampleStorage.RegisterInstance(ctx, instanceToStorage(instance))
+	if err != nil {
+		return nil, fmt.Errorf("cannot register instance | %w", mapStorageError(err))
+	}
+
+	s.watchHub.notify()
+
+	return instanceFromStorage(registered), nil
+}
+
+// InstanceHeartbeat renews the lease of an instance. Once an instance has missed its lease
+// it fails with ErrInstanceNotFound, and the instance has to register again.
+func (s *Service) InstanceHeartbeat(ctx context.Context, serviceName, instanceID string) (*Instance, error) {
+	instance, err := s.exampleStorage.InstanceHeartbeat(ctx, serviceName, instanceID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot renew instance lease | %w", mapStorageError(err))
+	}
+
+	return instanceFromStorage(instance), nil
+}
+
+func (s *Service) UnregisterInstance(ctx context.Context, serviceName, instanceID string) error {
+	if err := s.exampleStorage.DeleteInstance(ctx, serviceName, instanceID); err != nil {
+		return fmt.Errorf("cannot unregister instance | %w", mapStorageError(err))
+	}
+
+	s.watchHub.notify()
+
+	return nil
+}
+
+func (s *Service) ListInstances(ctx context.Context, serviceName string) ([]*Instance, error) {
+	stored, err := s.exampleStorage.ListInstances(ctx, serviceName)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list instances | %w", mapStorageError(err))
+	}
+
+	instances := make([]*Instance, 0, len(stored))
+	for _, instance := range stored {
+		instances = append(instances, instanceFromStorage(instance))
+	}
+
+	return instances, nil
+}
+
+// reapInstances deletes the instances that missed their lease, it is a part of reapLeases.
+func (s *Service) reapInstances(ctx context.Context) bool {
+	expired, err := s.exampleStorage.DeleteExpiredInstances(ctx)
+	if err != nil {
+		s.logger.Error("cannot delete expired instances", zap.Error(err))
+	}
+
+	if len(expired) == 0 {
+		return false
+	}
+
+	instances := make([]string, 0, len(expired))
+	for _, instance := range expired {
+		instances = append(instances, instance.ServiceName+"/"+instance.ID)
+	}
+
+	s.logger.Info("expired instances deleted", zap.Strings("instances", instances))
+
+	return true
+}
+
+func instanceToStorage(instance *Instance) *exampleRepo.Instance {
+	return &exampleRepo.Instance{
+		ServiceName:    instance.ServiceName,
+		ID:             instance.InstanceID,
+		Endpoints:      endpointsToStorage(instance.Endpoints),
+		LeaseTTL:       instance.LeaseTTL,
+		LeaseExpiresAt: instance.LeaseExpiresAt,
+		RegisteredAt:   instance.RegisteredAt,
+	}
+}
+
+func instanceFromStorage(instance *exampleRepo.Instance) *Instance {
+	if instance == nil {
+		return nil
+	}
+
+	return &Instance{
+		ServiceName:    instance.ServiceName,
+		InstanceID:     instance.ID,
+		Endpoints:      endpointsFromStorage(instance.Endpoints),
+		LeaseTTL:       instance.LeaseTTL,
+		LeaseExpiresAt: instance.LeaseExpiresAt,
+		RegisteredAt:   instance.RegisteredAt,
+	}
+}
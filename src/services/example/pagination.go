@@ -0,0 +1,106 @@
+package example
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 1000
+
+	pageTokenVersion = 1
+)
+
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+type SortField int
+
+const (
+	SortFieldServiceName SortField = iota
+)
+
+type ListServicesParams struct {
+	PageSize   int
+	PageToken  string
+	NamePrefix string
+	// LabelSelector is parsed with ParseSelector, empty selects every service.
+	LabelSelector string
+	SortField     SortField
+	Descending    bool
+}
+
+type ServicesPage struct {
+	Registrations []*Registration
+	// NextPageToken is empty on the last page.
+	NextPageToken string
+}
+
+// pageToken is the decoded form of an opaque page token. Fields are addressed by their JSON names only,
+// so new sort keys can be added to pageCursor without invalidating tokens already handed out to clients.
+type pageToken struct {
+	Version    int        `json:"v"`
+	NamePrefix string     `json:"p,omitempty"`
+	Selector   string     `json:"l,omitempty"`
+	SortField  SortField  `json:"f"`
+	Descending bool       `json:"d,omitempty"`
+	After      pageCursor `json:"a"`
+}
+
+type pageCursor struct {
+	ServiceName string `json:"n"`
+}
+
+// encodePageToken serializes any of the page token structs of the package.
+func encodePageToken(token any) (string, error) {
+	raw, err := json.Marshal(token)
+	if err != nil {
+		return "", fmt.Errorf("cannot marshal page token | %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+// decodePageToken parses a page token and checks that it was issued for the same filtering and sorting.
+func decodePageToken(params *ListServicesParams) (*pageToken, error) {
+	var token pageToken
+	if err := unmarshalPageToken(params.PageToken, &token); err != nil {
+		return nil, err
+	}
+
+	if token.Version != pageTokenVersion ||
+		token.NamePrefix != params.NamePrefix ||
+		token.Selector != params.LabelSelector ||
+		token.SortField != params.SortField ||
+		token.Descending != params.Descending {
+		return nil, ErrInvalidPageToken
+	}
+
+	return &token, nil
+}
+
+func unmarshalPageToken(encoded string, token any) error {
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return ErrInvalidPageToken
+	}
+
+	if err = json.Unmarshal(raw, token); err != nil {
+		return ErrInvalidPageToken
+	}
+
+	return nil
+}
+
+func pageSize(requested int) int {
+	switch {
+	case requested <= 0:
+		return defaultPageSize
+	case requested > maxPageSize:
+		return maxPageSize
+	default:
+		return requested
+	}
+}
@@ -0,0 +1,88 @@
+package example
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	exampleRepo "github.com/ingvarmattis/example/src/repositories/example"
+)
+
+var ErrInvalidUpdateMask = errors.New("invalid update mask")
+
+// FieldViolation points at a field of a request, named as in the API.
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+// InvalidUpdateMaskError lists every problem of an update mask at once.
+type InvalidUpdateMaskError struct {
+	Violations []FieldViolation
+}
+
+func (e *InvalidUpdateMaskError) Error() string {
+	descriptions := make([]string, 0, len(e.Violations))
+	for _, violation := range e.Violations {
+		descriptions = append(descriptions, violation.Field+": "+violation.Description)
+	}
+
+	return ErrInvalidUpdateMask.Error() + ": " + strings.Join(descriptions, "; ")
+}
+
+func (e *InvalidUpdateMaskError) Unwrap() error {
+	return ErrInvalidUpdateMask
+}
+
+// PatchService updates the fields of a registration named by paths, which follow the field names
+// of the API, for example OwnerTeam or Probe. Other fields keep their values.
+// A non-zero expectedVersion works as in UpdateService.
+func (s *Service) PatchService(
+	ctx context.Context, serviceName string, registration *Registration, paths []string, expectedVersion int64,
+) (*Registration, error) {
+	if violations := updateMaskViolations(registration, paths); len(violations) > 0 {
+		return nil, &InvalidUpdateMaskError{Violations: violations}
+	}
+
+	updated, err := s.exampleStorage.PatchService(
+		ctx, serviceName, registrationToStorage(registration), paths, expectedVersion,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("cannot patch service | %w", mapStorageError(err))
+	}
+
+	s.watchHub.notify()
+
+	return registrationFromStorage(updated), nil
+}
+
+// updateMaskViolations checks paths against the fields the storage can patch.
+func updateMaskViolations(registration *Registration, paths []string) []FieldViolation {
+	if len(paths) == 0 {
+		return []FieldViolation{{Field: "UpdateMask", Description: "at least one path is required"}}
+	}
+
+	var violations []FieldViolation
+
+	for i, path := range paths {
+		if _, ok := exampleRepo.PatchFieldOf(path); !ok {
+			violations = append(violations, FieldViolation{
+				Field: "UpdateMask.Paths[" + strconv.Itoa(i) + "]",
+				Description: fmt.Sprintf("unknown path %q, expected one of %s",
+					path, strings.Join(exampleRepo.PatchFields(), ", ")),
+			})
+		}
+	}
+
+	if slices.Contains(paths, exampleRepo.PatchFieldServiceName) && registration.ServiceName == "" {
+		violations = append(violations, FieldViolation{
+			Field:       "Service.ServiceName",
+			Description: "a service cannot be renamed to an empty name",
+		})
+	}
+
+	return violations
+}
@@ -0,0 +1,296 @@
+package example
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	exampleRepo "github.com/ingvarmattis/example/src/repositories/example"
+)
+
+var ErrInvalidSelector = errors.New("invalid label selector")
+
+type SelectorOperator int
+
+const (
+	SelectorOperatorEquals SelectorOperator = iota + 1
+	// SelectorOperatorNotEquals also matches services without the label, as in Kubernetes.
+	SelectorOperatorNotEquals
+	SelectorOperatorIn
+	// SelectorOperatorNotIn also matches services without the label, as in Kubernetes.
+	SelectorOperatorNotIn
+	SelectorOperatorExists
+	SelectorOperatorDoesNotExist
+)
+
+// Requirement is a single comma-separated term of a label selector.
+type Requirement struct {
+	Key      string
+	Operator SelectorOperator
+	// Values holds one value for equality, none for existence checks.
+	Values []string
+}
+
+// Selector matches services that satisfy all of its requirements. An empty selector matches everything.
+type Selector []*Requirement
+
+// SelectorSyntaxError points at the token of a label selector that cannot be parsed.
+type SelectorSyntaxError struct {
+	// Position is the byte offset of Token in the selector.
+	Position int
+	// Token is empty at the end of the selector.
+	Token   string
+	Message string
+}
+
+func (e *SelectorSyntaxError) Error() string {
+	if e.Token == "" {
+		return fmt.Sprintf("%s at the end of the selector: %s", ErrInvalidSelector, e.Message)
+	}
+
+	return fmt.Sprintf("%s at position %d near %q: %s", ErrInvalidSelector, e.Position, e.Token, e.Message)
+}
+
+func (e *SelectorSyntaxError) Unwrap() error {
+	return ErrInvalidSelector
+}
+
+type selectorTokenKind int
+
+const (
+	selectorTokenEnd selectorTokenKind = iota
+	selectorTokenIdentifier
+	selectorTokenComma
+	selectorTokenOpenParen
+	selectorTokenCloseParen
+	selectorTokenEquals
+	selectorTokenNotEquals
+	selectorTokenNot
+)
+
+type selectorToken struct {
+	kind     selectorTokenKind
+	value    string
+	position int
+}
+
+// ParseSelector parses a Kubernetes-style label selector such as `team=payments,env!=dev,tier in (web,api),!canary`.
+func ParseSelector(selector string) (Selector, error) {
+	tokens, err := lexSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &selectorParser{tokens: tokens}
+
+	if p.peek().kind == selectorTokenEnd {
+		return Selector{}, nil
+	}
+
+	var result Selector
+
+	for {
+		requirement, parseErr := p.requirement()
+		if parseErr != nil {
+			return nil, parseErr
+		}
+
+		result = append(result, requirement)
+
+		switch token := p.next(); token.kind {
+		case selectorTokenEnd:
+			return result, nil
+		case selectorTokenComma:
+		case selectorTokenIdentifier, selectorTokenOpenParen, selectorTokenCloseParen,
+			selectorTokenEquals, selectorTokenNotEquals, selectorTokenNot:
+			return nil, syntaxError(token, "expected a comma between requirements")
+		}
+	}
+}
+
+func lexSelector(selector string) ([]selectorToken, error) {
+	var tokens []selectorToken
+
+	for i := 0; i < len(selector); {
+		char := selector[i]
+
+		switch {
+		case char == ' ' || char == '\t':
+			i++
+		case char == ',':
+			tokens = append(tokens, selectorToken{kind: selectorTokenComma, value: ",", position: i})
+			i++
+		case char == '(':
+			tokens = append(tokens, selectorToken{kind: selectorTokenOpenParen, value: "(", position: i})
+			i++
+		case char == ')':
+			tokens = append(tokens, selectorToken{kind: selectorTokenCloseParen, value: ")", position: i})
+			i++
+		case strings.HasPrefix(selector[i:], "!="):
+			tokens = append(tokens, selectorToken{kind: selectorTokenNotEquals, value: "!=", position: i})
+			i += 2
+		case char == '!':
+			tokens = append(tokens, selectorToken{kind: selectorTokenNot, value: "!", position: i})
+			i++
+		case strings.HasPrefix(selector[i:], "=="):
+			tokens = append(tokens, selectorToken{kind: selectorTokenEquals, value: "==", position: i})
+			i += 2
+		case char == '=':
+			tokens = append(tokens, selectorToken{kind: selectorTokenEquals, value: "=", position: i})
+			i++
+		case isSelectorIdentifierChar(char):
+			start := i
+			for i < len(selector) && isSelectorIdentifierChar(selector[i]) {
+				i++
+			}
+
+			tokens = append(tokens, selectorToken{kind: selectorTokenIdentifier, value: selector[start:i], position: start})
+		default:
+			return nil, &SelectorSyntaxError{Position: i, Token: string(char), Message: "unexpected character"}
+		}
+	}
+
+	return append(tokens, selectorToken{kind: selectorTokenEnd, value: "", position: len(selector)}), nil
+}
+
+// isSelectorIdentifierChar accepts characters of label keys and values, their exact format is checked on write.
+func isSelectorIdentifierChar(char byte) bool {
+	return char >= 'a' && char <= 'z' ||
+		char >= 'A' && char <= 'Z' ||
+		char >= '0' && char <= '9' ||
+		char == '-' || char == '_' || char == '.' || char == '/'
+}
+
+type selectorParser struct {
+	tokens []selectorToken
+	pos    int
+}
+
+func (p *selectorParser) peek() selectorToken {
+	return p.tokens[p.pos]
+}
+
+func (p *selectorParser) next() selectorToken {
+	token := p.tokens[p.pos]
+	if token.kind != selectorTokenEnd {
+		p.pos++
+	}
+
+	return token
+}
+
+func (p *selectorParser) requirement() (*Requirement, error) {
+	token := p.next()
+
+	if token.kind == selectorTokenNot {
+		key := p.next()
+		if key.kind != selectorTokenIdentifier {
+			return nil, syntaxError(key, "expected a label key after !")
+		}
+
+		return &Requirement{Key: key.value, Operator: SelectorOperatorDoesNotExist, Values: nil}, nil
+	}
+
+	if token.kind != selectorTokenIdentifier {
+		return nil, syntaxError(token, "expected a label key")
+	}
+
+	key := token.value
+
+	switch operator := p.peek(); {
+	case operator.kind == selectorTokenEnd || operator.kind == selectorTokenComma:
+		return &Requirement{Key: key, Operator: SelectorOperatorExists, Values: nil}, nil
+	case operator.kind == selectorTokenEquals || operator.kind == selectorTokenNotEquals:
+		p.next()
+
+		requirement := &Requirement{Key: key, Operator: SelectorOperatorEquals, Values: []string{""}}
+		if operator.kind == selectorTokenNotEquals {
+			requirement.Operator = SelectorOperatorNotEquals
+		}
+
+		// an empty value selects labels set to an empty string
+		if value := p.peek(); value.kind == selectorTokenIdentifier {
+			p.next()
+			requirement.Values[0] = value.value
+		}
+
+		return requirement, nil
+	case operator.kind == selectorTokenIdentifier && (operator.value == "in" || operator.value == "notin"):
+		p.next()
+
+		values, err := p.values()
+		if err != nil {
+			return nil, err
+		}
+
+		requirement := &Requirement{Key: key, Operator: SelectorOperatorIn, Values: values}
+		if operator.value == "notin" {
+			requirement.Operator = SelectorOperatorNotIn
+		}
+
+		return requirement, nil
+	default:
+		return nil, syntaxError(operator, "expected one of =, ==, !=, in, notin")
+	}
+}
+
+func (p *selectorParser) values() ([]string, error) {
+	if token := p.next(); token.kind != selectorTokenOpenParen {
+		return nil, syntaxError(token, "expected ( to open the list of values")
+	}
+
+	var values []string
+
+	for {
+		value := p.next()
+		if value.kind != selectorTokenIdentifier {
+			return nil, syntaxError(value, "expected a label value")
+		}
+
+		values = append(values, value.value)
+
+		switch token := p.next(); token.kind {
+		case selectorTokenCloseParen:
+			return values, nil
+		case selectorTokenComma:
+		case selectorTokenEnd, selectorTokenIdentifier, selectorTokenOpenParen,
+			selectorTokenEquals, selectorTokenNotEquals, selectorTokenNot:
+			return nil, syntaxError(token, "expected , or ) in the list of values")
+		}
+	}
+}
+
+func syntaxError(token selectorToken, message string) error {
+	return &SelectorSyntaxError{Position: token.position, Token: token.value, Message: message}
+}
+
+func selectorToStorage(selector Selector) []*exampleRepo.LabelRequirement {
+	requirements := make([]*exampleRepo.LabelRequirement, 0, len(selector))
+
+	for _, requirement := range selector {
+		var operator exampleRepo.LabelOperator
+
+		switch requirement.Operator {
+		case SelectorOperatorEquals:
+			operator = exampleRepo.LabelOperatorEquals
+		case SelectorOperatorNotEquals:
+			operator = exampleRepo.LabelOperatorNotEquals
+		case SelectorOperatorIn:
+			operator = exampleRepo.LabelOperatorIn
+		case SelectorOperatorNotIn:
+			operator = exampleRepo.LabelOperatorNotIn
+		case SelectorOperatorExists:
+			operator = exampleRepo.LabelOperatorExists
+		case SelectorOperatorDoesNotExist:
+			operator = exampleRepo.LabelOperatorDoesNotExist
+		}
+
+		requirements = append(requirements, &exampleRepo.LabelRequirement{
+			Key:      requirement.Key,
+			Operator: operator,
+			Values:   requirement.Values,
+		})
+	}
+
+	return requirements
+}
@@ -0,0 +1,138 @@
+package example
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseSelector(t *testing.T) {
+	tests := []struct {
+		name     string
+		selector string
+		want     Selector
+	}{
+		{name: "empty", selector: "", want: Selector{}},
+		{name: "blank", selector: "  ", want: Selector{}},
+		{
+			name:     "equals",
+			selector: "team=payments",
+			want:     Selector{{Key: "team", Operator: SelectorOperatorEquals, Values: []string{"payments"}}},
+		},
+		{
+			name:     "double equals",
+			selector: "team==payments",
+			want:     Selector{{Key: "team", Operator: SelectorOperatorEquals, Values: []string{"payments"}}},
+		},
+		{
+			name:     "not equals",
+			selector: "env!=dev",
+			want:     Selector{{Key: "env", Operator: SelectorOperatorNotEquals, Values: []string{"dev"}}},
+		},
+		{
+			name:     "in",
+			selector: "tier in (web,api)",
+			want:     Selector{{Key: "tier", Operator: SelectorOperatorIn, Values: []string{"web", "api"}}},
+		},
+		{
+			name:     "notin",
+			selector: "tier notin (batch)",
+			want:     Selector{{Key: "tier", Operator: SelectorOperatorNotIn, Values: []string{"batch"}}},
+		},
+		{
+			name:     "exists",
+			selector: "canary",
+			want:     Selector{{Key: "canary", Operator: SelectorOperatorExists, Values: nil}},
+		},
+		{
+			name:     "does not exist",
+			selector: "!canary",
+			want:     Selector{{Key: "canary", Operator: SelectorOperatorDoesNotExist, Values: nil}},
+		},
+		{
+			name:     "empty value",
+			selector: "team=",
+			want:     Selector{{Key: "team", Operator: SelectorOperatorEquals, Values: []string{""}}},
+		},
+		{
+			name:     "empty value before a comma",
+			selector: "team!=,canary",
+			want: Selector{
+				{Key: "team", Operator: SelectorOperatorNotEquals, Values: []string{""}},
+				{Key: "canary", Operator: SelectorOperatorExists, Values: nil},
+			},
+		},
+		{
+			name:     "prefixed key",
+			selector: "example.com/team=payments",
+			want: Selector{
+				{Key: "example.com/team", Operator: SelectorOperatorEquals, Values: []string{"payments"}},
+			},
+		},
+		{
+			name:     "several requirements with spaces",
+			selector: " team = payments , tier in ( web , api ) , !canary ",
+			want: Selector{
+				{Key: "team", Operator: SelectorOperatorEquals, Values: []string{"payments"}},
+				{Key: "tier", Operator: SelectorOperatorIn, Values: []string{"web", "api"}},
+				{Key: "canary", Operator: SelectorOperatorDoesNotExist, Values: nil},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ParseSelector(test.selector)
+			if err != nil {
+				t.Fatalf("ParseSelector(%q) error = %v", test.selector, err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Fatalf("ParseSelector(%q) = %v, want %v", test.selector, got, test.want)
+			}
+		})
+	}
+}
+
+func TestParseSelectorErrors(t *testing.T) {
+	tests := []struct {
+		name         string
+		selector     string
+		wantPosition int
+		wantToken    string
+	}{
+		{name: "empty list of values", selector: "tier in ()", wantPosition: 9, wantToken: ")"},
+		{name: "trailing comma in values", selector: "tier in (web,)", wantPosition: 13, wantToken: ")"},
+		{name: "unclosed values", selector: "tier in (web", wantPosition: 12, wantToken: ""},
+		{name: "values without parentheses", selector: "tier in web", wantPosition: 8, wantToken: "web"},
+		{name: "trailing comma", selector: "team=payments,", wantPosition: 14, wantToken: ""},
+		{name: "leading comma", selector: ",team", wantPosition: 0, wantToken: ","},
+		{name: "double comma", selector: "team,,canary", wantPosition: 5, wantToken: ","},
+		{name: "missing comma", selector: "team=payments canary", wantPosition: 14, wantToken: "canary"},
+		{name: "second operator", selector: "team=a=b", wantPosition: 6, wantToken: "="},
+		{name: "unknown operator", selector: "team is payments", wantPosition: 5, wantToken: "is"},
+		{name: "unexpected character", selector: "team>payments", wantPosition: 4, wantToken: ">"},
+		{name: "not without a key", selector: "!", wantPosition: 1, wantToken: ""},
+		{name: "operator without a key", selector: "=payments", wantPosition: 0, wantToken: "="},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ParseSelector(test.selector)
+
+			var syntaxErr *SelectorSyntaxError
+			if !errors.As(err, &syntaxErr) {
+				t.Fatalf("ParseSelector(%q) error = %v, want a SelectorSyntaxError", test.selector, err)
+			}
+
+			if !errors.Is(err, ErrInvalidSelector) {
+				t.Fatalf("ParseSelector(%q) error = %v, want ErrInvalidSelector", test.selector, err)
+			}
+
+			if syntaxErr.Position != test.wantPosition || syntaxErr.Token != test.wantToken {
+				t.Fatalf("ParseSelector(%q) error at %d near %q, want at %d near %q",
+					test.selector, syntaxErr.Position, syntaxErr.Token, test.wantPosition, test.wantToken)
+			}
+		})
+	}
+}
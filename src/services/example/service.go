@@ -4,13 +4,65 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
+	"go.uber.org/zap"
+
+	"github.com/ingvarmattis/example/src/actor"
+	"github.com/ingvarmattis/example/src/log"
 	exampleRepo "github.com/ingvarmattis/example/src/repositories/example"
 )
 
 const serviceName = "example-service"
 
-var ErrNotFound = errors.New("not found")
+var (
+	ErrNotFound          = errors.New("not found")
+	ErrAlreadyExists     = errors.New("already exists")
+	ErrDependencyCycle   = errors.New("dependency cycle")
+	ErrUnknownDependency = errors.New("unknown dependency")
+	ErrVersionMismatch   = errors.New("resource version mismatch")
+	// ErrUnavailable wraps the errors of a database that cannot be reached at the moment.
+	ErrUnavailable = errors.New("storage unavailable")
+)
+
+// Registration is a single entry of the service registry.
+type Registration struct {
+	ServiceName string
+	Description string
+	OwnerTeam   string
+	Version     string
+	Endpoints   []Endpoint
+	Labels      map[string]string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	// LeaseTTL is zero for registrations that never expire.
+	LeaseTTL       time.Duration
+	LeaseExpiresAt *time.Time
+	ExpiredAt      *time.Time
+	// Probe is nil for registrations that are not probed.
+	Probe *Endpoint
+	// DependsOn names the registered services this one depends on.
+	DependsOn []string
+	// ResourceVersion changes with every update, writers pass it back to detect concurrent changes.
+	ResourceVersion int64
+}
+
+// Expired reports whether the registration missed its lease.
+func (r *Registration) Expired() bool {
+	return r.ExpiredAt != nil
+}
+
+type EndpointProtocol string
+
+const (
+	EndpointProtocolGRPC EndpointProtocol = "grpc"
+	EndpointProtocolHTTP EndpointProtocol = "http"
+)
+
+type Endpoint struct {
+	Protocol EndpointProtocol
+	Address  string
+}
 
 //go:generate bash -c "mkdir -p mocks"
 //go:generate mockgen -source=service.go -destination=mocks/mocks.go -package=mocks
@@ -18,22 +70,92 @@ type exampleStorage interface {
 	ServiceName(ctx context.Context) (string, error)
 	RegisterService(ctx context.Context, serviceName string) error
 	Exists(ctx context.Context, serviceName string) (bool, error)
+	CreateService(ctx context.Context, service *exampleRepo.Service) (*exampleRepo.Service, error)
+	GetService(ctx context.Context, serviceName string) (*exampleRepo.Service, error)
+	UpdateService(
+		ctx context.Context, serviceName string, service *exampleRepo.Service, expectedVersion int64,
+	) (*exampleRepo.Service, error)
+	PatchService(
+		ctx context.Context, serviceName string, service *exampleRepo.Service, paths []string, expectedVersion int64,
+	) (*exampleRepo.Service, error)
+	DeleteService(ctx context.Context, serviceName string, expectedVersion int64) error
+	ListServices(ctx context.Context, filter *exampleRepo.ListServicesFilter) ([]*exampleRepo.Service, error)
+	Heartbeat(ctx context.Context, serviceName string) (*exampleRepo.Service, error)
+	ExpireLeases(ctx context.Context) ([]string, error)
+	DeleteExpiredServices(ctx context.Context, retention time.Duration) ([]string, error)
+	ListChanges(ctx context.Context, afterRevision int64, limit int) ([]*exampleRepo.Change, error)
+	RevisionRange(ctx context.Context) (int64, int64, error)
+	PruneChanges(ctx context.Context, retention time.Duration) (int64, error)
+	TryLockProbes(ctx context.Context) (*exampleRepo.ProbeLock, error)
+	ListProbeTargets(ctx context.Context) ([]*exampleRepo.ProbeTarget, error)
+	SaveProbeResult(ctx context.Context, result *exampleRepo.ProbeResult) error
+	GetHealth(ctx context.Context, serviceName string) (*exampleRepo.Health, error)
+	ListServiceEvents(ctx context.Context, filter *exampleRepo.ServiceEventsFilter) ([]*exampleRepo.Event, error)
+	ImportServices(
+		ctx context.Context, services []*exampleRepo.Service, events []*exampleRepo.Event,
+		mode exampleRepo.ImportMode, dryRun bool,
+	) (*exampleRepo.ImportResult, error)
+	RegisterInstance(ctx context.Context, instance *exampleRepo.Instance) (*exampleRepo.Instance, error)
+	InstanceHeartbeat(ctx context.Context, serviceName, instanceID string) (*exampleRepo.Instance, error)
+	DeleteInstance(ctx context.Context, serviceName, instanceID string) error
+	ListInstances(ctx context.Context, serviceName string) ([]*exampleRepo.Instance, error)
+	DeleteExpiredInstances(ctx context.Context) ([]*exampleRepo.Instance, error)
+	ListDependencyEdges(
+		ctx context.Context, serviceName string, direction exampleRepo.DependencyDirection, maxDepth int,
+	) ([]*exampleRepo.DependencyEdge, error)
+}
+
+// changeNotifier announces revisions committed to the change log, including those written by other replicas.
+// A zero revision means notifications may have been missed and the log has to be re-read.
+type changeNotifier interface {
+	Subscribe() (<-chan int64, func())
 }
 
 type Service struct {
 	exampleStorage exampleStorage
+	logger         *log.Zap
+
+	watchHub *watchHub
+
+	healthChecker healthChecker
+	probeParams   *ProbeParams
 }
 
-func NewService(ctx context.Context, exampleStorage exampleStorage) (*Service, error) {
-	service := &Service{exampleStorage: exampleStorage}
+// NewService registers the service itself and starts tailing the change log; the tailing stops with ctx.
+// Writes of this instance are picked up right away, writes of other replicas once notifier announces them.
+func NewService(
+	ctx context.Context, logger *log.Zap, exampleStorage exampleStorage, notifier changeNotifier,
+	healthChecker healthChecker, probeParams *ProbeParams,
+) (*Service, error) {
+	_, revision, err := exampleStorage.RevisionRange(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current revision | %w", err)
+	}
+
+	service := &Service{
+		exampleStorage: exampleStorage,
+		logger:         logger,
+		watchHub:       newWatchHub(exampleStorage, logger, revision),
+		healthChecker:  healthChecker,
+		probeParams:    probeParams,
+	}
+
+	go service.watchHub.run(ctx, notifier)
 
-	if err := service.exampleStorage.RegisterService(ctx, serviceName); err != nil {
+	if err = service.exampleStorage.RegisterService(actor.WithActor(ctx, actor.Bootstrap), serviceName); err != nil {
 		return nil, fmt.Errorf("failed register service | %w", err)
 	}
 
+	service.watchHub.notify()
+
 	return service, nil
 }
 
+// Close ends all running watches.
+func (s *Service) Close() {
+	s.watchHub.close()
+}
+
 func (s *Service) ServiceName(ctx context.Context) (string, error) {
 	svcName, err := s.exampleStorage.ServiceName(ctx)
 	if err != nil {
@@ -41,7 +163,7 @@ func (s *Service) ServiceName(ctx context.Context) (string, error) {
 			return "", ErrNotFound
 		}
 
-		return "", fmt.Errorf("cannot auth | %w", err)
+		return "", fmt.Errorf("cannot auth | %w", mapStorageError(err))
 	}
 
 	return svcName, nil
@@ -50,8 +172,304 @@ func (s *Service) ServiceName(ctx context.Context) (string, error) {
 func (s *Service) Exists(ctx context.Context, serviceName string) (bool, error) {
 	exists, err := s.exampleStorage.Exists(ctx, serviceName)
 	if err != nil {
-		return false, fmt.Errorf("cannot auth | %w", err)
+		return false, fmt.Errorf("cannot auth | %w", mapStorageError(err))
 	}
 
 	return exists, nil
 }
+
+func (s *Service) RegisterService(ctx context.Context, registration *Registration) (*Registration, error) {
+	created, err := s.exampleStorage.CreateService(ctx, registrationToStorage(registration))
+	if err != nil {
+		return nil, fmt.Errorf("cannot register service | %w", mapStorageError(err))
+	}
+
+	s.watchHub.notify()
+
+	return registrationFromStorage(created), nil
+}
+
+func (s *Service) GetService(ctx context.Context, serviceName string) (*Registration, error) {
+	service, err := s.exampleStorage.GetService(ctx, serviceName)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get service | %w", mapStorageError(err))
+	}
+
+	return registrationFromStorage(service), nil
+}
+
+// UpdateService replaces the metadata of a registration. The service keeps its name
+// unless registration.ServiceName is set. A non-zero expectedVersion has to match the resource version
+// of the registration, otherwise the update fails with ErrVersionMismatch and changes nothing.
+func (s *Service) UpdateService(
+	ctx context.Context, serviceName string, registration *Registration, expectedVersion int64,
+) (*Registration, error) {
+	if registration.ServiceName == "" {
+		registration.ServiceName = serviceName
+	}
+
+	updated, err := s.exampleStorage.UpdateService(
+		ctx, serviceName, registrationToStorage(registration), expectedVersion,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("cannot update service | %w", mapStorageError(err))
+	}
+
+	s.watchHub.notify()
+
+	return registrationFromStorage(updated), nil
+}
+
+func (s *Service) Heartbeat(ctx context.Context, serviceName string) (*Registration, error) {
+	service, err := s.exampleStorage.Heartbeat(ctx, serviceName)
+	if err != nil {
+		return nil, fmt.Errorf("cannot renew lease | %w", mapStorageError(err))
+	}
+
+	s.watchHub.notify()
+
+	return registrationFromStorage(service), nil
+}
+
+// ReapLeases blocks until ctx is done. Every interval it marks registrations that missed their lease
+// as expired and deletes the ones that stayed expired for longer than retention.
+// Instances that missed their lease are deleted right away.
+func (s *Service) ReapLeases(ctx context.Context, interval, retention time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.reapLeases(ctx, retention)
+		}
+	}
+}
+
+func (s *Service) reapLeases(ctx context.Context, retention time.Duration) {
+	ctx = actor.WithActor(ctx, actor.LeaseReaper)
+
+	expired, err := s.exampleStorage.ExpireLeases(ctx)
+	if err != nil {
+		s.logger.Error("cannot expire leases", zap.Error(err))
+	}
+
+	if len(expired) > 0 {
+		s.logger.Info("registrations expired", zap.Strings("services", expired))
+	}
+
+	deleted, err := s.exampleStorage.DeleteExpiredServices(ctx, retention)
+	if err != nil {
+		s.logger.Error("cannot delete expired registrations", zap.Error(err))
+	}
+
+	if len(deleted) > 0 {
+		s.logger.Info("expired registrations deleted", zap.Strings("services", deleted))
+	}
+
+	reaped := s.reapInstances(ctx)
+
+	if len(expired) > 0 || len(deleted) > 0 || reaped {
+		s.watchHub.notify()
+	}
+}
+
+// UnregisterService deletes a registration, a non-zero expectedVersion works as in UpdateService.
+func (s *Service) UnregisterService(ctx context.Context, serviceName string, expectedVersion int64) error {
+	if err := s.exampleStorage.DeleteService(ctx, serviceName, expectedVersion); err != nil {
+		return fmt.Errorf("cannot unregister service | %w", mapStorageError(err))
+	}
+
+	s.watchHub.notify()
+
+	return nil
+}
+
+func (s *Service) ListServices(ctx context.Context, params *ListServicesParams) (*ServicesPage, error) {
+	selector, err := ParseSelector(params.LabelSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := &exampleRepo.ListServicesFilter{
+		NamePrefix: params.NamePrefix,
+		Labels:     selectorToStorage(selector),
+		SortField:  sortFieldToStorage(params.SortField),
+		Descending: params.Descending,
+		After:      nil,
+		// one extra row tells whether there is a next page
+		Limit: pageSize(params.PageSize) + 1,
+	}
+
+	if params.PageToken != "" {
+		token, err := decodePageToken(params)
+		if err != nil {
+			return nil, err
+		}
+
+		filter.After = &exampleRepo.ServiceCursor{Name: token.After.ServiceName}
+	}
+
+	services, err := s.exampleStorage.ListServices(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list services | %w", mapStorageError(err))
+	}
+
+	page := &ServicesPage{
+		Registrations: make([]*Registration, 0, len(services)),
+	}
+
+	if len(services) == filter.Limit {
+		services = services[:len(services)-1]
+
+		last := services[len(services)-1]
+
+		page.NextPageToken, err = encodePageToken(&pageToken{
+			Version:    pageTokenVersion,
+			NamePrefix: params.NamePrefix,
+			Selector:   params.LabelSelector,
+			SortField:  params.SortField,
+			Descending: params.Descending,
+			After:      pageCursor{ServiceName: last.Name},
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, service := range services {
+		page.Registrations = append(page.Registrations, registrationFromStorage(service))
+	}
+
+	return page, nil
+}
+
+func mapStorageError(err error) error {
+	var (
+		cycleErr    *exampleRepo.DependencyCycleError
+		unknownErr  *exampleRepo.UnknownDependencyError
+		mismatchErr *exampleRepo.VersionMismatchError
+	)
+
+	switch {
+	case errors.Is(err, exampleRepo.ErrInvalidPatch):
+		return &InvalidUpdateMaskError{Violations: []FieldViolation{{Field: "UpdateMask", Description: err.Error()}}}
+	case errors.As(err, &mismatchErr):
+		return fmt.Errorf("%w | %w", ErrVersionMismatch, mismatchErr)
+	case errors.As(err, &cycleErr):
+		return fmt.Errorf("%w | %w", ErrDependencyCycle, cycleErr)
+	case errors.As(err, &unknownErr):
+		return fmt.Errorf("%w | %w", ErrUnknownDependency, unknownErr)
+	case errors.Is(err, exampleRepo.ErrNotFound):
+		return ErrNotFound
+	case errors.Is(err, exampleRepo.ErrInstanceNotFound):
+		return ErrInstanceNotFound
+	case errors.Is(err, exampleRepo.ErrAlreadyExists):
+		return ErrAlreadyExists
+	case exampleRepo.IsUnavailable(err):
+		return fmt.Errorf("%w | %w", ErrUnavailable, err)
+	default:
+		return err
+	}
+}
+
+func sortFieldToStorage(field SortField) exampleRepo.SortField {
+	switch field {
+	case SortFieldServiceName:
+		return exampleRepo.SortFieldServiceName
+	default:
+		return exampleRepo.SortFieldServiceName
+	}
+}
+
+func registrationToStorage(registration *Registration) *exampleRepo.Service {
+	return &exampleRepo.Service{
+		Name:        registration.ServiceName,
+		Description: registration.Description,
+		OwnerTeam:   registration.OwnerTeam,
+		Version:     registration.Version,
+		Endpoints:   endpointsToStorage(registration.Endpoints),
+		Labels:      registration.Labels,
+		CreatedAt:   registration.CreatedAt,
+		UpdatedAt:   registration.UpdatedAt,
+
+		LeaseTTL:       registration.LeaseTTL,
+		LeaseExpiresAt: registration.LeaseExpiresAt,
+		ExpiredAt:      registration.ExpiredAt,
+
+		Probe:     endpointToStorage(registration.Probe),
+		DependsOn: registration.DependsOn,
+
+		ResourceVersion: registration.ResourceVersion,
+	}
+}
+
+func endpointsToStorage(endpoints []Endpoint) []exampleRepo.Endpoint {
+	stored := make([]exampleRepo.Endpoint, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		stored = append(stored, exampleRepo.Endpoint{
+			Protocol: string(endpoint.Protocol),
+			Address:  endpoint.Address,
+		})
+	}
+
+	return stored
+}
+
+func endpointToStorage(endpoint *Endpoint) *exampleRepo.Endpoint {
+	if endpoint == nil {
+		return nil
+	}
+
+	return &exampleRepo.Endpoint{
+		Protocol: string(endpoint.Protocol),
+		Address:  endpoint.Address,
+	}
+}
+
+func registrationFromStorage(service *exampleRepo.Service) *Registration {
+	return &Registration{
+		ServiceName: service.Name,
+		Description: service.Description,
+		OwnerTeam:   service.OwnerTeam,
+		Version:     service.Version,
+		Endpoints:   endpointsFromStorage(service.Endpoints),
+		Labels:      service.Labels,
+		CreatedAt:   service.CreatedAt,
+		UpdatedAt:   service.UpdatedAt,
+
+		LeaseTTL:       service.LeaseTTL,
+		LeaseExpiresAt: service.LeaseExpiresAt,
+		ExpiredAt:      service.ExpiredAt,
+
+		Probe:     endpointFromStorage(service.Probe),
+		DependsOn: service.DependsOn,
+
+		ResourceVersion: service.ResourceVersion,
+	}
+}
+
+func endpointsFromStorage(endpoints []exampleRepo.Endpoint) []Endpoint {
+	result := make([]Endpoint, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		result = append(result, Endpoint{
+			Protocol: EndpointProtocol(endpoint.Protocol),
+			Address:  endpoint.Address,
+		})
+	}
+
+	return result
+}
+
+func endpointFromStorage(endpoint *exampleRepo.Endpoint) *Endpoint {
+	if endpoint == nil {
+		return nil
+	}
+
+	return &Endpoint{
+		Protocol: EndpointProtocol(endpoint.Protocol),
+		Address:  endpoint.Address,
+	}
+}
@@ -0,0 +1,228 @@
+package example
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	exampleRepo "github.com/ingvarmattis/example/src/repositories/example"
+)
+
+const exportPageSize = 500
+
+var ErrImportConflict = errors.New("import conflict")
+
+// ExportRecord is a single entry of an export, either a registration or an event of the audit trail.
+type ExportRecord struct {
+	Registration *Registration
+	Event        *HistoryEvent
+}
+
+type ImportMode int
+
+const (
+	// ImportModeFail rejects the whole import when a service is registered with different metadata.
+	ImportModeFail ImportMode = iota
+	ImportModeSkip
+	ImportModeUpsert
+)
+
+type ImportAction int
+
+const (
+	ImportActionCreate ImportAction = iota + 1
+	ImportActionUpdate
+	ImportActionUnchanged
+	ImportActionSkip
+)
+
+type ImportParams struct {
+	Registrations []*Registration
+	// Events are appended to the audit trail as they are, with their original actors and times.
+	Events []*HistoryEvent
+	Mode   ImportMode
+	// DryRun reports what the import would do without changing anything.
+	DryRun bool
+}
+
+type ImportDiff struct {
+	ServiceName string
+	Action      ImportAction
+	// ChangedFields are field names of the API that differ from the registered service.
+	ChangedFields []string
+}
+
+type ImportResult struct {
+	Diff           []*ImportDiff
+	EventsImported int
+}
+
+// ExportServices sends every registration ordered by name, followed by the audit trail of the whole
+// registry when includeHistory is set. Pages are read one after another, not from a single snapshot.
+func (s *Service) ExportServices(ctx context.Context, includeHistory bool, send func(*ExportRecord) error) error {
+	filter := &exampleRepo.ListServicesFilter{
+		NamePrefix: "",
+		Labels:     nil,
+		SortField:  exampleRepo.SortFieldServiceName,
+		Descending: false,
+		After:      nil,
+		Limit:      exportPageSize,
+	}
+
+	for {
+		services, err := s.exampleStorage.ListServices(ctx, filter)
+		if err != nil {
+			return fmt.Errorf("cannot export services | %w", mapStorageError(err))
+		}
+
+		for _, service := range services {
+			if err = send(&ExportRecord{Registration: registrationFromStorage(service), Event: nil}); err != nil {
+				return fmt.Errorf("cannot send service | %w", err)
+			}
+		}
+
+		if len(services) < filter.Limit {
+			break
+		}
+
+		filter.After = &exampleRepo.ServiceCursor{Name: services[len(services)-1].Name}
+	}
+
+	if !includeHistory {
+		return nil
+	}
+
+	eventsFilter := &exampleRepo.ServiceEventsFilter{
+		ServiceName: "",
+		From:        nil,
+		To:          nil,
+		AfterID:     0,
+		Limit:       exportPageSize,
+	}
+
+	for {
+		events, err := s.exampleStorage.ListServiceEvents(ctx, eventsFilter)
+		if err != nil {
+			return fmt.Errorf("cannot export service history | %w", mapStorageError(err))
+		}
+
+		for _, event := range events {
+			if err = send(&ExportRecord{Registration: nil, Event: historyEventFromStorage(event)}); err != nil {
+				return fmt.Errorf("cannot send service event | %w", err)
+			}
+		}
+
+		if len(events) < eventsFilter.Limit {
+			return nil
+		}
+
+		eventsFilter.AfterID = events[len(events)-1].ID
+	}
+}
+
+// ImportServices loads registrations and events in a single transaction, all or nothing.
+func (s *Service) ImportServices(ctx context.Context, params *ImportParams) (*ImportResult, error) {
+	services := make([]*exampleRepo.Service, 0, len(params.Registrations))
+	for _, registration := range params.Registrations {
+		services = append(services, registrationToStorage(registration))
+	}
+
+	events := make([]*exampleRepo.Event, 0, len(params.Events))
+	for _, event := range params.Events {
+		events = append(events, historyEventToStorage(event))
+	}
+
+	var mode exampleRepo.ImportMode
+
+	switch params.Mode {
+	case ImportModeFail:
+		mode = exampleRepo.ImportModeFail
+	case ImportModeSkip:
+		mode = exampleRepo.ImportModeSkip
+	case ImportModeUpsert:
+		mode = exampleRepo.ImportModeUpsert
+	}
+
+	imported, err := s.exampleStorage.ImportServices(ctx, services, events, mode, params.DryRun)
+	if err != nil {
+		var conflictErr *exampleRepo.ImportConflictError
+		if errors.As(err, &conflictErr) {
+			return nil, fmt.Errorf("%w | %w", ErrImportConflict, conflictErr)
+		}
+
+		return nil, fmt.Errorf("cannot import services | %w", mapStorageError(err))
+	}
+
+	if !params.DryRun {
+		s.watchHub.notify()
+	}
+
+	result := &ImportResult{
+		Diff:           make([]*ImportDiff, 0, len(imported.Diff)),
+		EventsImported: imported.EventsImported,
+	}
+
+	for _, diff := range imported.Diff {
+		var action ImportAction
+
+		switch diff.Action {
+		case exampleRepo.ImportActionCreate:
+			action = ImportActionCreate
+		case exampleRepo.ImportActionUpdate:
+			action = ImportActionUpdate
+		case exampleRepo.ImportActionUnchanged:
+			action = ImportActionUnchanged
+		case exampleRepo.ImportActionSkip:
+			action = ImportActionSkip
+		}
+
+		result.Diff = append(result.Diff, &ImportDiff{
+			ServiceName:   diff.ServiceName,
+			Action:        action,
+			ChangedFields: diff.ChangedFields,
+		})
+	}
+
+	return result, nil
+}
+
+func historyEventToStorage(event *HistoryEvent) *exampleRepo.Event {
+	var eventType exampleRepo.EventType
+
+	switch event.Type {
+	case HistoryEventTypeRegistered:
+		eventType = exampleRepo.EventTypeRegistered
+	case HistoryEventTypeUpdated:
+		eventType = exampleRepo.EventTypeUpdated
+	case HistoryEventTypeRenamed:
+		eventType = exampleRepo.EventTypeRenamed
+	case HistoryEventTypeExpired:
+		eventType = exampleRepo.EventTypeExpired
+	case HistoryEventTypeRevived:
+		eventType = exampleRepo.EventTypeRevived
+	case HistoryEventTypeUnregistered:
+		eventType = exampleRepo.EventTypeUnregistered
+	case HistoryEventTypeDeleted:
+		eventType = exampleRepo.EventTypeDeleted
+	}
+
+	storageEvent := &exampleRepo.Event{
+		ID:          event.ID,
+		ServiceName: event.ServiceName,
+		Type:        eventType,
+		Actor:       event.Actor,
+		OldValue:    nil,
+		NewValue:    nil,
+		OccurredAt:  event.OccurredAt,
+	}
+
+	if event.OldValue != nil {
+		storageEvent.OldValue = registrationToStorage(event.OldValue)
+	}
+
+	if event.NewValue != nil {
+		storageEvent.NewValue = registrationToStorage(event.NewValue)
+	}
+
+	return storageEvent
+}
@@ -0,0 +1,355 @@
+package example
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/ingvarmattis/example/src/log"
+	exampleRepo "github.com/ingvarmattis/example/src/repositories/example"
+)
+
+const (
+	changesBatchSize  = 1000
+	watcherBufferSize = 256
+)
+
+var (
+	ErrRevisionCompacted = errors.New("revision has been compacted")
+	ErrWatchLagged       = errors.New("watcher fell behind the change stream")
+	ErrWatchClosed       = errors.New("change stream is closed")
+)
+
+type EventType int
+
+const (
+	EventTypeAdded EventType = iota + 1
+	EventTypeUpdated
+	EventTypeRemoved
+	// EventTypeInstanceAdded is sent again when a registered instance changes its endpoints.
+	EventTypeInstanceAdded
+	EventTypeInstanceRemoved
+)
+
+// Event is a single registry change. Revisions grow monotonically across the whole registry.
+type Event struct {
+	Revision int64
+	Type     EventType
+	// Registration is the state after the change, or the last known state for EventTypeRemoved.
+	Registration *Registration
+	// Instance is the instance an EventTypeInstanceAdded or EventTypeInstanceRemoved is about, nil otherwise.
+	Instance  *Instance
+	ChangedAt time.Time
+}
+
+// watchHub tails the change log and fans events out to in-process watchers.
+// It only reads the log after being notified, so every local write path has to call notify;
+// writes of other replicas arrive through the change notifier.
+type watchHub struct {
+	storage exampleStorage
+	logger  *log.Zap
+
+	pokes chan struct{}
+
+	mu       sync.Mutex
+	revision int64
+	watchers map[chan *Event]struct{}
+	closed   bool
+}
+
+func newWatchHub(storage exampleStorage, logger *log.Zap, revision int64) *watchHub {
+	return &watchHub{
+		storage:  storage,
+		logger:   logger,
+		pokes:    make(chan struct{}, 1),
+		revision: revision,
+		watchers: make(map[chan *Event]struct{}),
+	}
+}
+
+// notify schedules a read of the change log without blocking the caller.
+func (h *watchHub) notify() {
+	select {
+	case h.pokes <- struct{}{}:
+	default:
+	}
+}
+
+func (h *watchHub) run(ctx context.Context, notifier changeNotifier) {
+	revisions, unsubscribe := notifier.Subscribe()
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-ctx.Done():
+			h.close()
+			return
+		case revision := <-revisions:
+			if revision != 0 && revision <= h.currentRevision() {
+				continue
+			}
+
+			h.notify()
+		case <-h.pokes:
+			if err := h.catchUp(ctx); err != nil && ctx.Err() == nil {
+				h.logger.Error("cannot read service changes", zap.Error(err))
+			}
+		}
+	}
+}
+
+func (h *watchHub) currentRevision() int64 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.revision
+}
+
+func (h *watchHub) catchUp(ctx context.Context) error {
+	for {
+		after := h.currentRevision()
+
+		changes, err := h.storage.ListChanges(ctx, after, changesBatchSize)
+		if err != nil {
+			return fmt.Errorf("cannot list changes | %w", err)
+		}
+
+		h.broadcast(changes)
+
+		if len(changes) < changesBatchSize {
+			return nil
+		}
+	}
+}
+
+// broadcast delivers events to every watcher. A watcher whose buffer is full is dropped
+// instead of blocking the others; it can resume from the last revision it has seen.
+func (h *watchHub) broadcast(changes []*exampleRepo.Change) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, change := range changes {
+		event := eventFromStorage(change)
+
+		for watcher := range h.watchers {
+			select {
+			case watcher <- event:
+			default:
+				delete(h.watchers, watcher)
+				close(watcher)
+			}
+		}
+
+		h.revision = change.Revision
+	}
+}
+
+// subscribe returns a channel with every event after the returned revision.
+func (h *watchHub) subscribe() (chan *Event, int64, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		return nil, 0, ErrWatchClosed
+	}
+
+	watcher := make(chan *Event, watcherBufferSize)
+	h.watchers[watcher] = struct{}{}
+
+	return watcher, h.revision, nil
+}
+
+func (h *watchHub) unsubscribe(watcher chan *Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, ok := h.watchers[watcher]; ok {
+		delete(h.watchers, watcher)
+		close(watcher)
+	}
+}
+
+// close ends every watch, so streaming RPCs do not hold up a graceful shutdown.
+func (h *watchHub) close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.closed = true
+
+	for watcher := range h.watchers {
+		delete(h.watchers, watcher)
+		close(watcher)
+	}
+}
+
+// endReason tells why the hub closed a watcher channel.
+func (h *watchHub) endReason() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		return ErrWatchClosed
+	}
+
+	return ErrWatchLagged
+}
+
+// WatchServices calls send for every registry change after fromRevision until ctx is done.
+// Zero fromRevision starts from the current revision. Events older than the in-process stream
+// are replayed from the change log first. subscribed is called with the current revision once
+// the watch is in place, so anything read by the caller afterwards can only be older than its events.
+// A non-empty serviceName only sends the events of that service.
+func (s *Service) WatchServices(
+	ctx context.Context, fromRevision int64, serviceName string,
+	subscribed func(revision int64) error, send func(*Event) error,
+) error {
+	if serviceName != "" {
+		send = onlyService(serviceName, send)
+	}
+
+	watcher, current, err := s.watchHub.subscribe()
+	if err != nil {
+		return err
+	}
+	defer s.watchHub.unsubscribe(watcher)
+
+	if err = subscribed(current); err != nil {
+		return fmt.Errorf("cannot confirm subscription | %w", err)
+	}
+
+	last := current
+	if fromRevision > 0 {
+		if last, err = s.replayChanges(ctx, fromRevision, current, send); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher:
+			if !ok {
+				return s.watchHub.endReason()
+			}
+
+			if event.Revision <= last {
+				continue
+			}
+
+			if err = send(event); err != nil {
+				return fmt.Errorf("cannot send event | %w", err)
+			}
+
+			last = event.Revision
+		}
+	}
+}
+
+// onlyService drops the events of other services than serviceName. They still count as seen by the watcher.
+func onlyService(serviceName string, send func(*Event) error) func(*Event) error {
+	return func(event *Event) error {
+		if event.Registration.ServiceName != serviceName {
+			return nil
+		}
+
+		return send(event)
+	}
+}
+
+// replayChanges sends changes in (fromRevision, upToRevision] and returns the last revision the watcher has seen.
+func (s *Service) replayChanges(
+	ctx context.Context, fromRevision, upToRevision int64, send func(*Event) error,
+) (int64, error) {
+	if fromRevision >= upToRevision {
+		return fromRevision, nil
+	}
+
+	oldest, _, err := s.exampleStorage.RevisionRange(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("cannot get revision range | %w", mapStorageError(err))
+	}
+
+	if oldest > fromRevision+1 {
+		return 0, ErrRevisionCompacted
+	}
+
+	last := fromRevision
+	for last < upToRevision {
+		changes, listErr := s.exampleStorage.ListChanges(ctx, last, changesBatchSize)
+		if listErr != nil {
+			return 0, fmt.Errorf("cannot list changes | %w", mapStorageError(listErr))
+		}
+
+		if len(changes) == 0 {
+			break
+		}
+
+		for _, change := range changes {
+			if change.Revision > upToRevision {
+				return last, nil
+			}
+
+			if err = send(eventFromStorage(change)); err != nil {
+				return 0, fmt.Errorf("cannot send event | %w", err)
+			}
+
+			last = change.Revision
+		}
+	}
+
+	return last, nil
+}
+
+// PruneChanges blocks until ctx is done, deleting changes older than retention every interval.
+// Watchers resuming from a pruned revision get ErrRevisionCompacted.
+func (s *Service) PruneChanges(ctx context.Context, interval, retention time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			pruned, err := s.exampleStorage.PruneChanges(ctx, retention)
+			if err != nil {
+				s.logger.Error("cannot prune service changes", zap.Error(err))
+				continue
+			}
+
+			if pruned > 0 {
+				s.logger.Info("service changes pruned", zap.Int64("count", pruned))
+			}
+		}
+	}
+}
+
+func eventFromStorage(change *exampleRepo.Change) *Event {
+	var eventType EventType
+
+	switch change.Type {
+	case exampleRepo.ChangeTypeAdded:
+		eventType = EventTypeAdded
+	case exampleRepo.ChangeTypeUpdated:
+		eventType = EventTypeUpdated
+	case exampleRepo.ChangeTypeRemoved:
+		eventType = EventTypeRemoved
+	case exampleRepo.ChangeTypeInstanceAdded:
+		eventType = EventTypeInstanceAdded
+	case exampleRepo.ChangeTypeInstanceRemoved:
+		eventType = EventTypeInstanceRemoved
+	}
+
+	return &Event{
+		Revision:     change.Revision,
+		Type:         eventType,
+		Registration: registrationFromStorage(change.Service),
+		Instance:     instanceFromStorage(change.Instance),
+		ChangedAt:    change.ChangedAt,
+	}
+}
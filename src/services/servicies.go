@@ -2,6 +2,8 @@ package services
 
 import (
 	"context"
+
+	exampleSvc "github.com/ingvarmattis/example/src/services/example"
 )
 
 type SvcLayer struct {
@@ -11,4 +13,33 @@ type SvcLayer struct {
 type ExampleService interface {
 	ServiceName(ctx context.Context) (string, error)
 	Exists(ctx context.Context, serviceName string) (bool, error)
+	RegisterService(ctx context.Context, registration *exampleSvc.Registration) (*exampleSvc.Registration, error)
+	GetService(ctx context.Context, serviceName string) (*exampleSvc.Registration, error)
+	UpdateService(
+		ctx context.Context, serviceName string, registration *exampleSvc.Registration, expectedVersion int64,
+	) (*exampleSvc.Registration, error)
+	PatchService(
+		ctx context.Context, serviceName string, registration *exampleSvc.Registration,
+		paths []string, expectedVersion int64,
+	) (*exampleSvc.Registration, error)
+	UnregisterService(ctx context.Context, serviceName string, expectedVersion int64) error
+	Heartbeat(ctx context.Context, serviceName string) (*exampleSvc.Registration, error)
+	WatchServices(
+		ctx context.Context, fromRevision int64, serviceName string,
+		subscribed func(revision int64) error, send func(*exampleSvc.Event) error,
+	) error
+	ListServices(ctx context.Context, params *exampleSvc.ListServicesParams) (*exampleSvc.ServicesPage, error)
+	ExportServices(ctx context.Context, includeHistory bool, send func(*exampleSvc.ExportRecord) error) error
+	ImportServices(ctx context.Context, params *exampleSvc.ImportParams) (*exampleSvc.ImportResult, error)
+	RegisterInstance(ctx context.Context, instance *exampleSvc.Instance) (*exampleSvc.Instance, error)
+	InstanceHeartbeat(ctx context.Context, serviceName, instanceID string) (*exampleSvc.Instance, error)
+	UnregisterInstance(ctx context.Context, serviceName, instanceID string) error
+	ListInstances(ctx context.Context, serviceName string) ([]*exampleSvc.Instance, error)
+	GetHealth(ctx context.Context, serviceName string) (*exampleSvc.Health, error)
+	GetServiceHistory(
+		ctx context.Context, params *exampleSvc.ServiceHistoryParams,
+	) (*exampleSvc.ServiceHistoryPage, error)
+	GetDependencies(
+		ctx context.Context, serviceName string, direction exampleSvc.DependencyDirection, maxDepth int,
+	) (*exampleSvc.DependencyGraph, error)
 }
@@ -0,0 +1,77 @@
+package webrpc
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// The JSON messages of gRPC-Web and Connect reach the gRPC server as application/grpc+json.
+func init() {
+	encoding.RegisterCodec(jsonCodec{})
+}
+
+// jsonCodec is the protojson encoding the Connect protocol specifies for JSON messages.
+type jsonCodec struct{}
+
+func (jsonCodec) Name() string {
+	return "json"
+}
+
+func (jsonCodec) Marshal(v any) ([]byte, error) {
+	message, ok := v.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("cannot marshal %T, not a protobuf message", v)
+	}
+
+	return protojson.Marshal(message)
+}
+
+func (jsonCodec) Unmarshal(data []byte, v any) error {
+	message, ok := v.(proto.Message)
+	if !ok {
+		return fmt.Errorf("cannot unmarshal into %T, not a protobuf message", v)
+	}
+
+	return protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, message)
+}
+
+func statusCode(st *status.Status) string {
+	return strconv.Itoa(int(st.Code()))
+}
+
+// statusDetails is the grpc-status-details-bin of st, empty when it has no details.
+func statusDetails(st *status.Status) string {
+	if len(st.Proto().GetDetails()) == 0 {
+		return ""
+	}
+
+	raw, err := proto.Marshal(st.Proto())
+	if err != nil {
+		return ""
+	}
+
+	return base64.RawStdEncoding.EncodeToString(raw)
+}
+
+// encodeGRPCMessage percent-encodes the grpc-message header, gRPC keeps printable ASCII but the percent sign.
+func encodeGRPCMessage(message string) string {
+	var encoded strings.Builder
+
+	for _, b := range []byte(message) {
+		if b >= ' ' && b <= '~' && b != '%' {
+			encoded.WriteByte(b)
+			continue
+		}
+
+		_, _ = fmt.Fprintf(&encoded, "%%%02X", b)
+	}
+
+	return encoded.String()
+}
@@ -0,0 +1,280 @@
+package webrpc
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	connectTimeoutHeader         = "Connect-Timeout-Ms"
+	connectProtocolVersionHeader = "Connect-Protocol-Version"
+	connectContentEncodingHeader = "Connect-Content-Encoding"
+	connectAcceptEncodingHeader  = "Connect-Accept-Encoding"
+
+	// connectTrailerPrefix turns the trailers of a unary call into headers
+	connectTrailerPrefix = "Trailer-"
+)
+
+// connectCodes are the names and the HTTP statuses of the codes in the Connect protocol.
+var connectCodes = map[codes.Code]struct {
+	name       string
+	httpStatus int
+}{
+	codes.Canceled:           {name: "canceled", httpStatus: 499},
+	codes.Unknown:            {name: "unknown", httpStatus: http.StatusInternalServerError},
+	codes.InvalidArgument:    {name: "invalid_argument", httpStatus: http.StatusBadRequest},
+	codes.DeadlineExceeded:   {name: "deadline_exceeded", httpStatus: http.StatusGatewayTimeout},
+	codes.NotFound:           {name: "not_found", httpStatus: http.StatusNotFound},
+	codes.AlreadyExists:      {name: "already_exists", httpStatus: http.StatusConflict},
+	codes.PermissionDenied:   {name: "permission_denied", httpStatus: http.StatusForbidden},
+	codes.ResourceExhausted:  {name: "resource_exhausted", httpStatus: http.StatusTooManyRequests},
+	codes.FailedPrecondition: {name: "failed_precondition", httpStatus: http.StatusBadRequest},
+	codes.Aborted:            {name: "aborted", httpStatus: http.StatusConflict},
+	codes.OutOfRange:         {name: "out_of_range", httpStatus: http.StatusBadRequest},
+	codes.Unimplemented:      {name: "unimplemented", httpStatus: http.StatusNotImplemented},
+	codes.Internal:           {name: "internal", httpStatus: http.StatusInternalServerError},
+	codes.Unavailable:        {name: "unavailable", httpStatus: http.StatusServiceUnavailable},
+	codes.DataLoss:           {name: "data_loss", httpStatus: http.StatusInternalServerError},
+	codes.Unauthenticated:    {name: "unauthenticated", httpStatus: http.StatusUnauthorized},
+}
+
+// connectError is the JSON of an error in the Connect protocol.
+type connectError struct {
+	Code    string          `json:"code"`
+	Message string          `json:"message,omitempty"`
+	Details []connectDetail `json:"details,omitempty"`
+}
+
+type connectDetail struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+	// Debug is the detail as JSON, for people reading the response
+	Debug json.RawMessage `json:"debug,omitempty"`
+}
+
+func newConnectError(st *status.Status) *connectError {
+	code, ok := connectCodes[st.Code()]
+	if !ok {
+		code = connectCodes[codes.Unknown]
+	}
+
+	e := &connectError{Code: code.name, Message: st.Message(), Details: nil}
+
+	for _, detail := range st.Proto().GetDetails() {
+		typeName := detail.GetTypeUrl()
+		typeName = typeName[strings.LastIndex(typeName, "/")+1:]
+
+		var debug json.RawMessage
+		if message, err := detail.UnmarshalNew(); err == nil {
+			debug, _ = protojson.Marshal(message)
+		}
+
+		e.Details = append(e.Details, connectDetail{
+			Type:  typeName,
+			Value: base64.RawStdEncoding.EncodeToString(detail.GetValue()),
+			Debug: debug,
+		})
+	}
+
+	return e
+}
+
+// connectRequest checks the Connect headers of r and replaces them with their gRPC counterparts.
+func connectRequest(r *http.Request, subtype string, body io.Reader) (*http.Request, error) {
+	for _, header := range []string{"Content-Encoding", connectContentEncodingHeader} {
+		if encoding := r.Header.Get(header); encoding != "" && encoding != "identity" {
+			return nil, status.Errorf(codes.Unimplemented, "compression %s is not supported", encoding)
+		}
+	}
+
+	req := grpcRequest(r, subtype, body)
+
+	if timeout := r.Header.Get(connectTimeoutHeader); timeout != "" {
+		milliseconds, err := strconv.ParseInt(timeout, 10, 64)
+		if err != nil || milliseconds < 0 || len(timeout) > 10 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid %s %q", connectTimeoutHeader, timeout)
+		}
+
+		req.Header.Set(grpcTimeoutHeader, grpcTimeout(time.Duration(milliseconds)*time.Millisecond))
+	}
+
+	for _, header := range []string{
+		connectTimeoutHeader, connectProtocolVersionHeader, connectContentEncodingHeader, connectAcceptEncodingHeader,
+		"Content-Encoding", "Accept-Encoding",
+	} {
+		req.Header.Del(header)
+	}
+
+	return req, nil
+}
+
+// grpcTimeout formats a timeout the way the grpc-timeout header has it, at most eight digits and a unit.
+func grpcTimeout(timeout time.Duration) string {
+	const maxValue = 99999999
+
+	if milliseconds := timeout.Milliseconds(); milliseconds <= maxValue {
+		return strconv.FormatInt(milliseconds, 10) + "m"
+	}
+
+	return strconv.FormatInt(min(int64(timeout/time.Second), maxValue), 10) + "S"
+}
+
+// serveConnectUnary serves a Connect unary request. The request and the response are bare messages,
+// an error is a JSON object with an HTTP status of its code, and the trailers are headers with a prefix.
+func (h *Handler) serveConnectUnary(w http.ResponseWriter, r *http.Request, subtype string) {
+	message, err := io.ReadAll(io.LimitReader(r.Body, maxUnaryRequestSize+1))
+	if err != nil {
+		writeConnectUnaryError(w, status.New(codes.Canceled, fmt.Sprintf("cannot read request | %v", err)))
+		return
+	}
+
+	if len(message) > maxUnaryRequestSize {
+		writeConnectUnaryError(w, status.Newf(codes.ResourceExhausted, "request is larger than %d bytes", maxUnaryRequestSize))
+		return
+	}
+
+	req, err := connectRequest(r, subtype, bytes.NewReader(envelope(0, message)))
+	if err != nil {
+		writeConnectUnaryError(w, status.Convert(err))
+		return
+	}
+
+	out := &connectUnaryTranslator{
+		w:           w,
+		contentType: "application/" + subtype,
+		header:      nil,
+		message:     nil,
+	}
+
+	serveGRPC(h.grpcServer, req, out)
+}
+
+type connectUnaryTranslator struct {
+	w           http.ResponseWriter
+	contentType string
+
+	// header and message are held back until the status tells whether the call succeeded
+	header  http.Header
+	message []byte
+}
+
+func (t *connectUnaryTranslator) headers(header http.Header) {
+	t.header = header
+}
+
+func (t *connectUnaryTranslator) frame(frame []byte) {
+	t.message = frame[frameHeaderSize:]
+}
+
+func (t *connectUnaryTranslator) flush() {}
+
+func (t *connectUnaryTranslator) finish(st *status.Status, trailers http.Header) {
+	copyHeaders(t.w, t.header)
+
+	for key, values := range trailers {
+		for _, value := range values {
+			t.w.Header().Add(connectTrailerPrefix+key, value)
+		}
+	}
+
+	if st.Code() != codes.OK {
+		writeConnectUnaryError(t.w, st)
+		return
+	}
+
+	t.w.Header().Set("Content-Type", t.contentType)
+	t.w.Header().Set("Content-Length", strconv.Itoa(len(t.message)))
+	t.w.WriteHeader(http.StatusOK)
+
+	_, _ = t.w.Write(t.message)
+}
+
+func writeConnectUnaryError(w http.ResponseWriter, st *status.Status) {
+	code, ok := connectCodes[st.Code()]
+	if !ok {
+		code = connectCodes[codes.Unknown]
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code.httpStatus)
+
+	_ = json.NewEncoder(w).Encode(newConnectError(st))
+}
+
+// serveConnectStream serves a Connect streaming request. Messages are framed as in gRPC, the status and
+// the trailers follow them in a JSON end of stream frame, and the HTTP status is 200 whatever the outcome.
+func (h *Handler) serveConnectStream(w http.ResponseWriter, r *http.Request, subtype string) {
+	out := &connectStreamTranslator{
+		w:           w,
+		contentType: "application/connect+" + subtype,
+		wroteHeader: false,
+	}
+
+	req, err := connectRequest(r, subtype, r.Body)
+	if err != nil {
+		out.finish(status.Convert(err), http.Header{})
+		return
+	}
+
+	serveGRPC(h.grpcServer, req, out)
+}
+
+type connectStreamTranslator struct {
+	w           http.ResponseWriter
+	contentType string
+	wroteHeader bool
+}
+
+// connectEndStream is the JSON of the last frame of a Connect stream.
+type connectEndStream struct {
+	Error    *connectError       `json:"error,omitempty"`
+	Metadata map[string][]string `json:"metadata,omitempty"`
+}
+
+func (t *connectStreamTranslator) headers(header http.Header) {
+	copyHeaders(t.w, header)
+
+	t.w.Header().Set("Content-Type", t.contentType)
+	t.w.WriteHeader(http.StatusOK)
+
+	t.wroteHeader = true
+}
+
+func (t *connectStreamTranslator) frame(frame []byte) {
+	_, _ = t.w.Write(frame)
+}
+
+func (t *connectStreamTranslator) flush() {
+	_ = http.NewResponseController(t.w).Flush()
+}
+
+func (t *connectStreamTranslator) finish(st *status.Status, trailers http.Header) {
+	if !t.wroteHeader {
+		t.headers(http.Header{})
+	}
+
+	end := connectEndStream{Error: nil, Metadata: trailers}
+	if st.Code() != codes.OK {
+		end.Error = newConnectError(st)
+	}
+
+	raw, err := json.Marshal(end)
+	if err != nil {
+		raw = []byte(`{"error":{"code":"internal","message":"cannot marshal end of stream"}}`)
+	}
+
+	_, _ = t.w.Write(envelope(flagEndStream, raw))
+
+	t.flush()
+}
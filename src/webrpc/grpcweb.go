@@ -0,0 +1,112 @@
+package webrpc
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc/status"
+)
+
+// serveGRPCWeb serves a gRPC-Web request. Its messages are framed as in gRPC, base64 encoded for the text
+// variant, and the trailers follow the messages in a frame of their own.
+func (h *Handler) serveGRPCWeb(w http.ResponseWriter, r *http.Request, subtype string, text bool) {
+	body := io.Reader(r.Body)
+	contentType := "application/grpc-web+" + subtype
+
+	if text {
+		body = base64.NewDecoder(base64.StdEncoding, r.Body)
+		contentType = "application/grpc-web-text+" + subtype
+	}
+
+	out := &grpcWebTranslator{
+		w:           w,
+		contentType: contentType,
+		text:        text,
+		wroteHeader: false,
+		pending:     nil,
+	}
+
+	serveGRPC(h.grpcServer, grpcRequest(r, subtype, body), out)
+}
+
+type grpcWebTranslator struct {
+	w           http.ResponseWriter
+	contentType string
+	text        bool
+	wroteHeader bool
+
+	// pending are the bytes of the text variant not encoded yet, base64 encodes three at a time
+	pending []byte
+}
+
+func (t *grpcWebTranslator) headers(header http.Header) {
+	copyHeaders(t.w, header)
+
+	t.w.Header().Set("Content-Type", t.contentType)
+	t.w.WriteHeader(http.StatusOK)
+
+	t.wroteHeader = true
+}
+
+func (t *grpcWebTranslator) frame(frame []byte) {
+	t.write(frame)
+}
+
+func (t *grpcWebTranslator) flush() {
+	if t.text && len(t.pending) > 0 {
+		// the client decodes the body as a single base64 stream, padding only comes at its end
+		whole := len(t.pending) / 3 * 3
+		_, _ = t.w.Write([]byte(base64.StdEncoding.EncodeToString(t.pending[:whole])))
+		t.pending = slices.Clone(t.pending[whole:])
+	}
+
+	_ = http.NewResponseController(t.w).Flush()
+}
+
+func (t *grpcWebTranslator) finish(st *status.Status, trailers http.Header) {
+	if !t.wroteHeader {
+		t.headers(http.Header{})
+	}
+
+	trailers = trailers.Clone()
+	trailers.Set(grpcStatusHeader, statusCode(st))
+
+	if st.Message() != "" {
+		trailers.Set(grpcMessageHeader, encodeGRPCMessage(st.Message()))
+	}
+
+	if details := statusDetails(st); details != "" {
+		trailers.Set(grpcStatusDetailsHeader, details)
+	}
+
+	// gRPC-Web clients expect the names of the trailers in lower case
+	var block bytes.Buffer
+
+	for key, values := range trailers {
+		for _, value := range values {
+			block.WriteString(strings.ToLower(key) + ": " + value + "\r\n")
+		}
+	}
+
+	t.write(envelope(flagTrailers, block.Bytes()))
+
+	if t.text {
+		_, _ = t.w.Write([]byte(base64.StdEncoding.EncodeToString(t.pending)))
+		t.pending = nil
+	}
+
+	_ = http.NewResponseController(t.w).Flush()
+}
+
+func (t *grpcWebTranslator) write(data []byte) {
+	if t.text {
+		t.pending = append(t.pending, data...)
+		return
+	}
+
+	_, _ = t.w.Write(data)
+}
@@ -0,0 +1,336 @@
+// Package webrpc serves the gRPC-Web and Connect protocols with a gRPC server. Requests are translated into gRPC
+// and handed to the ServeHTTP of the server, so they reach the same services through the same interceptors as
+// native gRPC calls, and the responses are translated back.
+package webrpc
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// frameHeaderSize is the size of the prefix of every message of a stream, a byte of flags and the length.
+	frameHeaderSize = 5
+
+	// flagEndStream marks the last frame of a Connect stream, a JSON object with the status and the trailers.
+	flagEndStream = 0x02
+	// flagTrailers marks the last frame of a gRPC-Web response, the trailers in the syntax of HTTP/1 headers.
+	flagTrailers = 0x80
+
+	grpcContentType = "application/grpc"
+
+	grpcStatusHeader        = "Grpc-Status"
+	grpcMessageHeader       = "Grpc-Message"
+	grpcStatusDetailsHeader = "Grpc-Status-Details-Bin"
+	grpcTimeoutHeader       = "Grpc-Timeout"
+
+	// maxUnaryRequestSize bounds the body of a Connect unary request, it is the default limit of gRPC servers.
+	maxUnaryRequestSize = 4 << 20
+)
+
+type protocol int
+
+const (
+	protocolGRPCWeb protocol = iota + 1
+	protocolGRPCWebText
+	protocolConnectUnary
+	protocolConnectStream
+)
+
+// Handler serves gRPC-Web and Connect requests with grpcServer, usually a *grpc.Server.
+type Handler struct {
+	grpcServer http.Handler
+}
+
+func NewHandler(grpcServer http.Handler) *Handler {
+	return &Handler{grpcServer: grpcServer}
+}
+
+// Match reports whether r is a gRPC-Web or a Connect request.
+func (h *Handler) Match(r *http.Request) bool {
+	_, _, ok := detect(r)
+
+	return ok
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	proto, subtype, ok := detect(r)
+	if !ok {
+		http.Error(w, "not a grpc-web or connect request", http.StatusUnsupportedMediaType)
+		return
+	}
+
+	if r.ProtoMajor == 1 {
+		// the gRPC server reads the stream of requests while it writes the responses
+		_ = http.NewResponseController(w).EnableFullDuplex()
+	}
+
+	switch proto {
+	case protocolGRPCWeb, protocolGRPCWebText:
+		h.serveGRPCWeb(w, r, subtype, proto == protocolGRPCWebText)
+	case protocolConnectUnary:
+		h.serveConnectUnary(w, r, subtype)
+	case protocolConnectStream:
+		h.serveConnectStream(w, r, subtype)
+	}
+}
+
+// detect tells the protocol of r and the codec of its messages, proto or json.
+func detect(r *http.Request) (protocol, string, bool) {
+	if r.Method != http.MethodPost {
+		return 0, "", false
+	}
+
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return 0, "", false
+	}
+
+	base, subtype, _ := strings.Cut(mediaType, "+")
+	if subtype == "" {
+		subtype = "proto"
+	}
+
+	if subtype != "proto" && subtype != "json" {
+		return 0, "", false
+	}
+
+	switch {
+	case base == "application/grpc-web":
+		return protocolGRPCWeb, subtype, true
+	case base == "application/grpc-web-text":
+		return protocolGRPCWebText, subtype, true
+	case base == "application/connect":
+		return protocolConnectStream, subtype, true
+	case (mediaType == "application/proto" || mediaType == "application/json") && isProcedure(r.URL.Path):
+		// REST calls of the gateway are JSON too, they are told apart by the path
+		return protocolConnectUnary, strings.TrimPrefix(mediaType, "application/"), true
+	}
+
+	return 0, "", false
+}
+
+// isProcedure reports whether path names a method, such as /ingvarmattis.services.example.v1.ExampleService/GetService.
+func isProcedure(path string) bool {
+	service, method, ok := strings.Cut(strings.TrimPrefix(path, "/"), "/")
+
+	return ok && strings.Contains(service, ".") && method != "" && !strings.Contains(method, "/")
+}
+
+// grpcRequest is r turned into a gRPC request with body, the stream of its messages.
+func grpcRequest(r *http.Request, subtype string, body io.Reader) *http.Request {
+	req := r.Clone(r.Context())
+
+	req.Proto, req.ProtoMajor, req.ProtoMinor = "HTTP/2.0", 2, 0
+	req.ContentLength = -1
+	req.Body = struct {
+		io.Reader
+		io.Closer
+	}{Reader: body, Closer: r.Body}
+
+	req.Header.Set("Content-Type", grpcContentType+"+"+subtype)
+	req.Header.Set("Te", "trailers")
+	req.Header.Del("Content-Length")
+
+	return req
+}
+
+// grpcResponse is the ResponseWriter the gRPC server writes a response to. It splits the body into frames and
+// hands them, the headers and the trailers over to a translator.
+type grpcResponse struct {
+	header     http.Header
+	statusCode int
+	translator translator
+
+	// declared are the trailers the server announced in the Trailer header
+	declared map[string]bool
+	// pending is the part of a frame written so far
+	pending []byte
+}
+
+// translator writes a gRPC response in another protocol.
+type translator interface {
+	// headers are the response headers, without the trailers the gRPC server declares
+	headers(header http.Header)
+	// frame is a message with its prefix
+	frame(frame []byte)
+	flush()
+	// finish ends the response with the status of the call and the trailers other than the status
+	finish(st *status.Status, trailers http.Header)
+}
+
+func serveGRPC(grpcServer http.Handler, req *http.Request, t translator) {
+	resp := &grpcResponse{
+		header:     make(http.Header),
+		statusCode: 0,
+		translator: t,
+		declared:   nil,
+		pending:    nil,
+	}
+
+	grpcServer.ServeHTTP(resp, req)
+
+	resp.finish()
+}
+
+func (r *grpcResponse) Header() http.Header {
+	return r.header
+}
+
+func (r *grpcResponse) WriteHeader(statusCode int) {
+	if r.statusCode != 0 {
+		return
+	}
+
+	r.statusCode = statusCode
+	r.declared = make(map[string]bool)
+
+	headers := make(http.Header, len(r.header))
+
+	for key, values := range r.header {
+		switch {
+		case key == "Trailer":
+			for _, value := range values {
+				for name := range strings.SplitSeq(value, ",") {
+					r.declared[http.CanonicalHeaderKey(strings.TrimSpace(name))] = true
+				}
+			}
+		case strings.HasPrefix(key, http.TrailerPrefix), key == "Date":
+		default:
+			headers[key] = values
+		}
+	}
+
+	if statusCode == http.StatusOK {
+		r.translator.headers(headers)
+	}
+}
+
+func (r *grpcResponse) Write(data []byte) (int, error) {
+	r.WriteHeader(http.StatusOK)
+
+	if r.statusCode != http.StatusOK {
+		// a request the server refused, such as one of a stopping server, finish reports it
+		return len(data), nil
+	}
+
+	r.pending = append(r.pending, data...)
+
+	for len(r.pending) >= frameHeaderSize {
+		size := frameHeaderSize + int(binary.BigEndian.Uint32(r.pending[1:frameHeaderSize]))
+		if len(r.pending) < size {
+			break
+		}
+
+		r.translator.frame(r.pending[:size])
+		r.pending = r.pending[size:]
+	}
+
+	return len(data), nil
+}
+
+func (r *grpcResponse) Flush() {
+	r.WriteHeader(http.StatusOK)
+
+	if r.statusCode == http.StatusOK {
+		r.translator.flush()
+	}
+}
+
+func (r *grpcResponse) finish() {
+	if r.statusCode != http.StatusOK {
+		st := status.New(codes.Unavailable, "the grpc server did not serve the request")
+		if r.statusCode != 0 {
+			st = status.New(codes.Internal, "the grpc server refused the request: "+http.StatusText(r.statusCode))
+		}
+
+		r.translator.finish(st, http.Header{})
+
+		return
+	}
+
+	trailers := make(http.Header)
+
+	for key, values := range r.header {
+		switch {
+		case strings.HasPrefix(key, http.TrailerPrefix):
+			trailers[http.CanonicalHeaderKey(strings.TrimPrefix(key, http.TrailerPrefix))] = values
+		case r.declared[key]:
+			trailers[key] = values
+		}
+	}
+
+	st := statusFromTrailers(trailers)
+
+	trailers.Del(grpcStatusHeader)
+	trailers.Del(grpcMessageHeader)
+	trailers.Del(grpcStatusDetailsHeader)
+
+	r.translator.finish(st, trailers)
+}
+
+// statusFromTrailers reads the status the gRPC server wrote, with its details when there are any.
+func statusFromTrailers(trailers http.Header) *status.Status {
+	code, err := strconv.ParseUint(trailers.Get(grpcStatusHeader), 10, 32)
+	if err != nil {
+		return status.New(codes.Internal, "the grpc server did not send a status")
+	}
+
+	if details := trailers.Get(grpcStatusDetailsHeader); details != "" {
+		raw, decodeErr := decodeBinaryHeader(details)
+
+		var st spb.Status
+		if decodeErr == nil && proto.Unmarshal(raw, &st) == nil && st.GetCode() == int32(code) { //nolint:gosec // parsed into 32 bits
+			return status.FromProto(&st)
+		}
+	}
+
+	message, err := url.PathUnescape(trailers.Get(grpcMessageHeader))
+	if err != nil {
+		message = trailers.Get(grpcMessageHeader)
+	}
+
+	return status.New(codes.Code(code), message) //nolint:gosec // parsed into 32 bits
+}
+
+// decodeBinaryHeader decodes a -bin header, gRPC allows it with or without padding.
+func decodeBinaryHeader(value string) ([]byte, error) {
+	if len(value)%4 == 0 {
+		return base64.StdEncoding.DecodeString(value)
+	}
+
+	return base64.RawStdEncoding.DecodeString(value)
+}
+
+// envelope prefixes a message with the flags and its length.
+func envelope(flags byte, message []byte) []byte {
+	frame := make([]byte, frameHeaderSize, frameHeaderSize+len(message))
+	frame[0] = flags
+	binary.BigEndian.PutUint32(frame[1:], uint32(len(message))) //nolint:gosec // messages are bounded far below 4 GiB
+
+	return append(frame, message...)
+}
+
+// copyHeaders adds the headers to w, except those of the transport of the gRPC server.
+func copyHeaders(w http.ResponseWriter, headers http.Header) {
+	for key, values := range headers {
+		if key == "Content-Type" || key == "Content-Length" || key == "Grpc-Encoding" {
+			continue
+		}
+
+		for _, value := range values {
+			w.Header().Add(key, value)
+		}
+	}
+}